@@ -5,7 +5,12 @@ import (
 	"os"
 	"os/signal"
 
+	"github.com/danilotadeu/star_wars/api/film"
+	"github.com/danilotadeu/star_wars/api/people"
 	"github.com/danilotadeu/star_wars/api/planet"
+	"github.com/danilotadeu/star_wars/api/species"
+	"github.com/danilotadeu/star_wars/api/starship"
+	"github.com/danilotadeu/star_wars/api/vehicle"
 	"github.com/danilotadeu/star_wars/app"
 	_ "github.com/danilotadeu/star_wars/docs"
 	"github.com/gofiber/fiber/v2"
@@ -32,6 +37,21 @@ func Register(apps *app.Container, port string) {
 	// Planets
 	planet.NewAPI(baseAPI.Group("/planets"), apps)
 
+	// Films
+	film.NewAPI(baseAPI.Group("/films"), apps)
+
+	// People
+	people.NewAPI(baseAPI.Group("/people"), apps)
+
+	// Starships
+	starship.NewAPI(baseAPI.Group("/starships"), apps)
+
+	// Vehicles
+	vehicle.NewAPI(baseAPI.Group("/vehicles"), apps)
+
+	// Species
+	species.NewAPI(baseAPI.Group("/species"), apps)
+
 	fiberRoute.Get("/swagger/*", swagger.HandlerDefault)
 
 	logrus.WithFields(logrus.Fields{"trace": "api"}).Infof("Registered - Api")
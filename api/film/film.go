@@ -0,0 +1,277 @@
+package film
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/danilotadeu/star_wars/app"
+	errorsP "github.com/danilotadeu/star_wars/model/errors_handler"
+	filmModel "github.com/danilotadeu/star_wars/model/film"
+	genericModel "github.com/danilotadeu/star_wars/model/generic"
+	planetModel "github.com/danilotadeu/star_wars/model/planet"
+	"github.com/gofiber/fiber/v2"
+	"github.com/sirupsen/logrus"
+)
+
+type apiImpl struct {
+	apps *app.Container
+}
+
+// NewAPI film function..
+func NewAPI(g fiber.Router, apps *app.Container) {
+	api := apiImpl{
+		apps: apps,
+	}
+
+	g.Get("/", api.films)
+	g.Get("/:filmId", api.film)
+	g.Get("/:filmId/planets", api.filmPlanets)
+}
+
+// ShowFilm godoc
+// @Summary      Show a film
+// @Description  get film by ID
+// @Tags         films
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Film ID"
+// @Success      200  {object}  filmModel.Film
+// @Failure      400  {object}  errorsP.ErrorsResponse
+// @Failure      404  {object}  errorsP.ErrorsResponse
+// @Failure      500  {object}  errorsP.ErrorsResponse
+// @Router       /films/{id} [get]
+func (p *apiImpl) film(c *fiber.Ctx) error {
+	filmId := c.Params("filmId")
+	ifilmId, err := strconv.ParseInt(filmId, 10, 64)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.film.film.ParseInt"}).Error(err)
+		return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+			Message: "Por favor envie o id",
+		})
+	}
+
+	ctx := c.Context()
+	film, err := p.apps.Film.GetOneByID(ctx, ifilmId)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.film.film.GetOneByID"}).Error(err)
+		if errors.Is(err, filmModel.ErrorFilmNotFound) {
+			return c.Status(http.StatusNotFound).JSON(errorsP.ErrorsResponse{
+				Message: fmt.Sprintf("Filme (%d) não encontrado", ifilmId),
+			})
+		}
+		return c.Status(http.StatusInternalServerError).JSON(errorsP.ErrorsResponse{
+			Message: "Aconteceu um erro interno..",
+		})
+	}
+
+	return c.Status(http.StatusOK).JSON(film)
+}
+
+// ListFilms godoc
+// @Summary      List films
+// @Description  get films, by page or, when the cursor is sent, by cursor with the pagination of ResponseFilmsByCursor
+// @Tags         films
+// @Accept       json
+// @Produce      json
+// @Param page query int false "page, starting at 1"
+// @Param limit query int false "limit, at most 100"
+// @Param cursor query string false "next_cursor or prev_cursor of a previous response, instead of page"
+// @Param title query string false "title"
+// @Param director query string false "director"
+// @Success      200  {object}  filmModel.ResponseFilms
+// @Failure      400  {object}  errorsP.ErrorsResponse
+// @Failure      404  {object}  errorsP.ErrorsResponse
+// @Failure      500  {object}  errorsP.ErrorsResponse
+// @Router       /films [get]
+func (p *apiImpl) films(c *fiber.Ctx) error {
+	ctx := c.Context()
+
+	ilimit, err := genericModel.ParseLimit(c.Query("limit"))
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.film.films.ParseLimit"}).Error(err)
+		return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+			Message: "Por favor envie o limit corretamente.",
+		})
+	}
+
+	page := c.Query("page")
+	ipage, err := genericModel.ParsePage(page)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.film.films.ParsePage"}).Error(err)
+		return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+			Message: "Por favor envie o page corretamente.",
+		})
+	}
+
+	var cursor *genericModel.Cursor
+	if cursorValue := c.Query("cursor"); len(cursorValue) > 0 {
+		if len(page) > 0 {
+			return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+				Message: "Por favor envie o page ou o cursor, não os dois.",
+			})
+		}
+
+		cursorConv, err := genericModel.DecodeCursor(cursorValue)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "api.film.films.DecodeCursor"}).Error(err)
+			return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+				Message: "Por favor envie o cursor corretamente.",
+			})
+		}
+		cursor = cursorConv
+	}
+
+	filter := filmModel.Filter{
+		Title:    c.Query("title"),
+		Director: c.Query("director"),
+	}
+
+	if cursor != nil {
+		return p.filmsByCursor(c, cursor, ilimit, filter)
+	}
+
+	films, err := p.apps.Film.GetAllFilms(ctx, ipage, ilimit, filter)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.film.films.GetAllFilms"}).Error(err)
+		if errors.Is(err, filmModel.ErrorFilmNotFound) {
+			return c.Status(http.StatusNotFound).JSON(errorsP.ErrorsResponse{
+				Message: "Dados nao encontrados",
+			})
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(errorsP.ErrorsResponse{
+			Message: "Aconteceu um erro interno..",
+		})
+	}
+
+	total, err := p.apps.Film.GetTotalFilms(ctx, filter)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.film.films.GetTotalFilms"}).Error(err)
+		return c.Status(http.StatusInternalServerError).JSON(errorsP.ErrorsResponse{
+			Message: "Aconteceu um erro interno..",
+		})
+	}
+
+	pagination := genericModel.NewPagination(ipage, ilimit, *total)
+
+	return c.Status(http.StatusOK).JSON(filmModel.ResponseFilms{
+		Data:               films,
+		ResponsePagination: pagination.WithCursors(films[0].Cursor(), films[len(films)-1].Cursor()),
+	})
+}
+
+// filmsByCursor answer the list of films on the keyset pagination..
+func (p *apiImpl) filmsByCursor(c *fiber.Ctx, cursor *genericModel.Cursor, limit int64, filter filmModel.Filter) error {
+	ctx := c.Context()
+
+	films, pagination, err := p.apps.Film.GetFilmsByCursor(ctx, cursor, limit, filter)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.film.filmsByCursor.GetFilmsByCursor"}).Error(err)
+		if errors.Is(err, filmModel.ErrorFilmNotFound) {
+			return c.Status(http.StatusNotFound).JSON(errorsP.ErrorsResponse{
+				Message: "Dados nao encontrados",
+			})
+		}
+		if errors.Is(err, genericModel.ErrorInvalidCursor) {
+			return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+				Message: "Por favor envie o cursor corretamente.",
+			})
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(errorsP.ErrorsResponse{
+			Message: "Aconteceu um erro interno..",
+		})
+	}
+
+	total, err := p.apps.Film.GetTotalFilms(ctx, filter)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.film.filmsByCursor.GetTotalFilms"}).Error(err)
+		return c.Status(http.StatusInternalServerError).JSON(errorsP.ErrorsResponse{
+			Message: "Aconteceu um erro interno..",
+		})
+	}
+	pagination.Count = *total
+
+	return c.Status(http.StatusOK).JSON(filmModel.ResponseFilmsByCursor{
+		Data:               films,
+		ResponsePagination: *pagination,
+	})
+}
+
+// ListFilmPlanets godoc
+// @Summary      List the planets of a film
+// @Description  get planets by film ID
+// @Tags         films
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Film ID"
+// @Param page query int false "page, starting at 1"
+// @Param limit query int false "limit, at most 100"
+// @Success      200  {object}  planetModel.ResponsePlanets
+// @Failure      400  {object}  errorsP.ErrorsResponse
+// @Failure      404  {object}  errorsP.ErrorsResponse
+// @Failure      500  {object}  errorsP.ErrorsResponse
+// @Router       /films/{id}/planets [get]
+func (p *apiImpl) filmPlanets(c *fiber.Ctx) error {
+	filmId := c.Params("filmId")
+	ifilmId, err := strconv.ParseInt(filmId, 10, 64)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.film.filmPlanets.ParseInt"}).Error(err)
+		return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+			Message: "Por favor envie o id",
+		})
+	}
+
+	ctx := c.Context()
+
+	ilimit, err := genericModel.ParseLimit(c.Query("limit"))
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.film.filmPlanets.ParseLimit"}).Error(err)
+		return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+			Message: "Por favor envie o limit corretamente.",
+		})
+	}
+
+	ipage, err := genericModel.ParsePage(c.Query("page"))
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.film.filmPlanets.ParsePage"}).Error(err)
+		return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+			Message: "Por favor envie o page corretamente.",
+		})
+	}
+
+	planets, err := p.apps.Film.GetPlanetsByFilmID(ctx, ifilmId, ipage, ilimit)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.film.filmPlanets.GetPlanetsByFilmID"}).Error(err)
+		if errors.Is(err, filmModel.ErrorFilmNotFound) {
+			return c.Status(http.StatusNotFound).JSON(errorsP.ErrorsResponse{
+				Message: fmt.Sprintf("Filme (%d) não encontrado", ifilmId),
+			})
+		}
+
+		if errors.Is(err, planetModel.ErrorPlanetNotFound) {
+			return c.Status(http.StatusNotFound).JSON(errorsP.ErrorsResponse{
+				Message: "Dados nao encontrados",
+			})
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(errorsP.ErrorsResponse{
+			Message: "Aconteceu um erro interno..",
+		})
+	}
+
+	total, err := p.apps.Film.GetTotalPlanetsByFilmID(ctx, ifilmId)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.film.filmPlanets.GetTotalPlanetsByFilmID"}).Error(err)
+		return c.Status(http.StatusInternalServerError).JSON(errorsP.ErrorsResponse{
+			Message: "Aconteceu um erro interno..",
+		})
+	}
+
+	return c.Status(http.StatusOK).JSON(planetModel.ResponsePlanets{
+		Data:               planets,
+		ResponsePagination: genericModel.NewPagination(ipage, ilimit, *total),
+	})
+}
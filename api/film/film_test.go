@@ -0,0 +1,388 @@
+package film
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/danilotadeu/star_wars/app"
+	mockAppFilm "github.com/danilotadeu/star_wars/mock/app/film"
+	filmModel "github.com/danilotadeu/star_wars/model/film"
+	genericModel "github.com/danilotadeu/star_wars/model/generic"
+	planetModel "github.com/danilotadeu/star_wars/model/planet"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestHandlerGetFilmByID(t *testing.T) {
+	endpoint := "/films/:filmId"
+	cases := map[string]struct {
+		InputParamID       string
+		ExpectedErr        error
+		ExpectedStatusCode int
+		PrepareMockApp     func(mockFilmApp *mockAppFilm.MockApp)
+	}{
+		"should return success with film": {
+			InputParamID: "1",
+			ExpectedErr:  nil,
+			PrepareMockApp: func(mockFilmApp *mockAppFilm.MockApp) {
+				mockFilmApp.EXPECT().GetOneByID(gomock.Any(), int64(1)).Return(&filmModel.Film{
+					ID:       1,
+					Name:     "A New Hope",
+					Director: "George Lucas",
+				}, nil)
+			},
+			ExpectedStatusCode: http.StatusOK,
+		},
+		"should throw error with parse int": {
+			InputParamID: "xpto",
+			ExpectedErr:  nil,
+			PrepareMockApp: func(mockFilmApp *mockAppFilm.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should return with film not found": {
+			InputParamID: "1",
+			ExpectedErr:  nil,
+			PrepareMockApp: func(mockFilmApp *mockAppFilm.MockApp) {
+				mockFilmApp.EXPECT().GetOneByID(gomock.Any(), gomock.Any()).Return(nil, filmModel.ErrorFilmNotFound)
+			},
+			ExpectedStatusCode: http.StatusNotFound,
+		},
+		"should throw error": {
+			InputParamID: "1",
+			ExpectedErr:  nil,
+			PrepareMockApp: func(mockFilmApp *mockAppFilm.MockApp) {
+				mockFilmApp.EXPECT().GetOneByID(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			ExpectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			mockFilmApp := mockAppFilm.NewMockApp(ctrl)
+			cs.PrepareMockApp(mockFilmApp)
+
+			h := apiImpl{
+				apps: &app.Container{
+					Film: mockFilmApp,
+				},
+			}
+
+			app := fiber.New()
+			app.Get(endpoint, h.film)
+			req := httptest.NewRequest(http.MethodGet, strings.ReplaceAll(endpoint, ":filmId", cs.InputParamID), nil).WithContext(ctx)
+			req.Header.Set("Content-Type", fiber.MIMEApplicationJSON)
+			resp, err := app.Test(req, -1)
+			if err != nil {
+				t.Errorf("Error app.Test: %s", err.Error())
+				return
+			}
+
+			assert.Equal(t, cs.ExpectedErr, err)
+			assert.Equal(t, cs.ExpectedStatusCode, resp.StatusCode)
+		})
+	}
+}
+
+func TestHandlerGetFilms(t *testing.T) {
+	cursor := genericModel.Cursor{Keys: []string{"4"}, ID: 1}
+	cases := map[string]struct {
+		InputPage          string
+		InputLimit         string
+		InputQuery         string
+		ExpectedErr        error
+		ExpectedStatusCode int
+		PrepareMockApp     func(mockFilmApp *mockAppFilm.MockApp)
+	}{
+		"should return success with films": {
+			InputPage:   "1",
+			InputLimit:  "10",
+			ExpectedErr: nil,
+			PrepareMockApp: func(mockFilmApp *mockAppFilm.MockApp) {
+				mockFilmApp.EXPECT().GetAllFilms(gomock.Any(), int64(1), int64(10), filmModel.Filter{}).Return([]*filmModel.Film{
+					{
+						ID:   1,
+						Name: "A New Hope",
+					},
+				}, nil)
+				var total int64 = 1
+				mockFilmApp.EXPECT().GetTotalFilms(gomock.Any(), filmModel.Filter{}).Return(&total, nil)
+			},
+			ExpectedStatusCode: http.StatusOK,
+		},
+		"should return success with title and director filter": {
+			InputQuery:  "title=hope&director=lucas",
+			ExpectedErr: nil,
+			PrepareMockApp: func(mockFilmApp *mockAppFilm.MockApp) {
+				filter := filmModel.Filter{
+					Title:    "hope",
+					Director: "lucas",
+				}
+				mockFilmApp.EXPECT().GetAllFilms(gomock.Any(), int64(1), int64(10), filter).Return([]*filmModel.Film{
+					{
+						ID:       1,
+						Name:     "A New Hope",
+						Director: "George Lucas",
+					},
+				}, nil)
+				var total int64 = 1
+				mockFilmApp.EXPECT().GetTotalFilms(gomock.Any(), filter).Return(&total, nil)
+			},
+			ExpectedStatusCode: http.StatusOK,
+		},
+		"should return success with cursor": {
+			InputQuery:  "cursor=" + cursor.Encode(),
+			ExpectedErr: nil,
+			PrepareMockApp: func(mockFilmApp *mockAppFilm.MockApp) {
+				mockFilmApp.EXPECT().GetFilmsByCursor(gomock.Any(), &cursor, int64(10), filmModel.Filter{}).Return([]*filmModel.Film{
+					{
+						ID:   2,
+						Name: "The Empire Strikes Back",
+					},
+				}, &genericModel.CursorPagination{Limit: 10}, nil)
+				var total int64 = 2
+				mockFilmApp.EXPECT().GetTotalFilms(gomock.Any(), filmModel.Filter{}).Return(&total, nil)
+			},
+			ExpectedStatusCode: http.StatusOK,
+		},
+		"should throw error with invalid cursor": {
+			InputQuery:  "cursor=xpto",
+			ExpectedErr: nil,
+			PrepareMockApp: func(mockFilmApp *mockAppFilm.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should throw error with page and cursor": {
+			InputQuery:  "page=1&cursor=" + cursor.Encode(),
+			ExpectedErr: nil,
+			PrepareMockApp: func(mockFilmApp *mockAppFilm.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should return with films not found after the cursor": {
+			InputQuery:  "cursor=" + cursor.Encode(),
+			ExpectedErr: nil,
+			PrepareMockApp: func(mockFilmApp *mockAppFilm.MockApp) {
+				mockFilmApp.EXPECT().GetFilmsByCursor(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil, filmModel.ErrorFilmNotFound)
+			},
+			ExpectedStatusCode: http.StatusNotFound,
+		},
+		"should throw error when get total films": {
+			InputPage:   "1",
+			InputLimit:  "10",
+			ExpectedErr: nil,
+			PrepareMockApp: func(mockFilmApp *mockAppFilm.MockApp) {
+				mockFilmApp.EXPECT().GetAllFilms(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*filmModel.Film{
+					{
+						ID:   1,
+						Name: "A New Hope",
+					},
+				}, nil)
+				mockFilmApp.EXPECT().GetTotalFilms(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			ExpectedStatusCode: http.StatusInternalServerError,
+		},
+		"should throw error with parse int page": {
+			ExpectedErr: nil,
+			InputPage:   "xpto",
+			PrepareMockApp: func(mockFilmApp *mockAppFilm.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should throw error with parse int limit": {
+			ExpectedErr: nil,
+			InputLimit:  "xpto",
+			PrepareMockApp: func(mockFilmApp *mockAppFilm.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should throw error with page zero": {
+			ExpectedErr: nil,
+			InputPage:   "0",
+			PrepareMockApp: func(mockFilmApp *mockAppFilm.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should throw error with limit zero": {
+			ExpectedErr: nil,
+			InputLimit:  "0",
+			PrepareMockApp: func(mockFilmApp *mockAppFilm.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should return with films not found": {
+			ExpectedErr: nil,
+			PrepareMockApp: func(mockFilmApp *mockAppFilm.MockApp) {
+				mockFilmApp.EXPECT().GetAllFilms(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, filmModel.ErrorFilmNotFound)
+			},
+			ExpectedStatusCode: http.StatusNotFound,
+		},
+		"should throw error": {
+			ExpectedErr: nil,
+			PrepareMockApp: func(mockFilmApp *mockAppFilm.MockApp) {
+				mockFilmApp.EXPECT().GetAllFilms(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			ExpectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			mockFilmApp := mockAppFilm.NewMockApp(ctrl)
+			cs.PrepareMockApp(mockFilmApp)
+
+			h := apiImpl{
+				apps: &app.Container{
+					Film: mockFilmApp,
+				},
+			}
+			endpoint := "/films"
+			app := fiber.New()
+			app.Get(endpoint, h.films)
+
+			if len(cs.InputPage) > 0 && len(cs.InputLimit) > 0 {
+				endpoint += "?page=" + cs.InputPage + "&limit=" + cs.InputLimit
+			} else if len(cs.InputPage) > 0 {
+				endpoint += "?page=" + cs.InputPage
+			} else if len(cs.InputLimit) > 0 {
+				endpoint += "?limit=" + cs.InputLimit
+			} else if len(cs.InputQuery) > 0 {
+				endpoint += "?" + cs.InputQuery
+			}
+
+			req := httptest.NewRequest(http.MethodGet, endpoint, nil).WithContext(ctx)
+			req.Header.Set("Content-Type", fiber.MIMEApplicationJSON)
+			resp, err := app.Test(req, -1)
+			if err != nil {
+				t.Errorf("Error app.Test: %s", err.Error())
+				return
+			}
+
+			assert.Equal(t, cs.ExpectedErr, err)
+			assert.Equal(t, cs.ExpectedStatusCode, resp.StatusCode)
+		})
+	}
+}
+
+func TestHandlerGetFilmPlanets(t *testing.T) {
+	endpoint := "/films/:filmId/planets"
+	cases := map[string]struct {
+		InputParamID       string
+		InputQuery         string
+		ExpectedErr        error
+		ExpectedStatusCode int
+		PrepareMockApp     func(mockFilmApp *mockAppFilm.MockApp)
+	}{
+		"should return success": {
+			InputParamID: "1",
+			InputQuery:   "?page=1&limit=10",
+			ExpectedErr:  nil,
+			PrepareMockApp: func(mockFilmApp *mockAppFilm.MockApp) {
+				mockFilmApp.EXPECT().GetPlanetsByFilmID(gomock.Any(), int64(1), int64(1), int64(10)).Return([]*planetModel.PlanetDB{
+					{ID: 1, Name: "Tatooine"},
+				}, nil)
+				var total int64 = 1
+				mockFilmApp.EXPECT().GetTotalPlanetsByFilmID(gomock.Any(), int64(1)).Return(&total, nil)
+			},
+			ExpectedStatusCode: http.StatusOK,
+		},
+		"should throw error with page zero": {
+			InputParamID: "1",
+			InputQuery:   "?page=0",
+			ExpectedErr:  nil,
+			PrepareMockApp: func(mockFilmApp *mockAppFilm.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should throw error with limit zero": {
+			InputParamID: "1",
+			InputQuery:   "?limit=0",
+			ExpectedErr:  nil,
+			PrepareMockApp: func(mockFilmApp *mockAppFilm.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should throw error with parse int": {
+			InputParamID: "xpto",
+			ExpectedErr:  nil,
+			PrepareMockApp: func(mockFilmApp *mockAppFilm.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should throw error with parse int page": {
+			InputParamID: "1",
+			InputQuery:   "?page=xpto",
+			ExpectedErr:  nil,
+			PrepareMockApp: func(mockFilmApp *mockAppFilm.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should return not found when the parent does not exist": {
+			InputParamID: "1",
+			ExpectedErr:  nil,
+			PrepareMockApp: func(mockFilmApp *mockAppFilm.MockApp) {
+				mockFilmApp.EXPECT().GetPlanetsByFilmID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, filmModel.ErrorFilmNotFound)
+			},
+			ExpectedStatusCode: http.StatusNotFound,
+		},
+		"should return not found without links": {
+			InputParamID: "1",
+			ExpectedErr:  nil,
+			PrepareMockApp: func(mockFilmApp *mockAppFilm.MockApp) {
+				mockFilmApp.EXPECT().GetPlanetsByFilmID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, planetModel.ErrorPlanetNotFound)
+			},
+			ExpectedStatusCode: http.StatusNotFound,
+		},
+		"should throw error when get total": {
+			InputParamID: "1",
+			ExpectedErr:  nil,
+			PrepareMockApp: func(mockFilmApp *mockAppFilm.MockApp) {
+				mockFilmApp.EXPECT().GetPlanetsByFilmID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*planetModel.PlanetDB{
+					{ID: 1, Name: "Tatooine"},
+				}, nil)
+				mockFilmApp.EXPECT().GetTotalPlanetsByFilmID(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			ExpectedStatusCode: http.StatusInternalServerError,
+		},
+		"should throw error": {
+			InputParamID: "1",
+			ExpectedErr:  nil,
+			PrepareMockApp: func(mockFilmApp *mockAppFilm.MockApp) {
+				mockFilmApp.EXPECT().GetPlanetsByFilmID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			ExpectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			mockFilmApp := mockAppFilm.NewMockApp(ctrl)
+			cs.PrepareMockApp(mockFilmApp)
+
+			h := apiImpl{
+				apps: &app.Container{
+					Film: mockFilmApp,
+				},
+			}
+
+			app := fiber.New()
+			app.Get(endpoint, h.filmPlanets)
+			req := httptest.NewRequest(http.MethodGet, strings.ReplaceAll(endpoint, ":filmId", cs.InputParamID)+cs.InputQuery, nil).WithContext(ctx)
+			req.Header.Set("Content-Type", fiber.MIMEApplicationJSON)
+			resp, err := app.Test(req, -1)
+			if err != nil {
+				t.Errorf("Error app.Test: %s", err.Error())
+				return
+			}
+
+			assert.Equal(t, cs.ExpectedErr, err)
+			assert.Equal(t, cs.ExpectedStatusCode, resp.StatusCode)
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package people
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/danilotadeu/star_wars/app"
+	errorsP "github.com/danilotadeu/star_wars/model/errors_handler"
+	genericModel "github.com/danilotadeu/star_wars/model/generic"
+	peopleModel "github.com/danilotadeu/star_wars/model/people"
+	"github.com/gofiber/fiber/v2"
+	"github.com/sirupsen/logrus"
+)
+
+type apiImpl struct {
+	apps *app.Container
+}
+
+// NewAPI people function..
+func NewAPI(g fiber.Router, apps *app.Container) {
+	api := apiImpl{
+		apps: apps,
+	}
+
+	g.Get("/", api.people)
+	g.Get("/:peopleId", api.person)
+}
+
+// ShowPeople godoc
+// @Summary      Show a people
+// @Description  get people by ID
+// @Tags         people
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "People ID"
+// @Success      200  {object}  peopleModel.PeopleDB
+// @Failure      400  {object}  errorsP.ErrorsResponse
+// @Failure      404  {object}  errorsP.ErrorsResponse
+// @Failure      500  {object}  errorsP.ErrorsResponse
+// @Router       /people/{id} [get]
+func (p *apiImpl) person(c *fiber.Ctx) error {
+	peopleId := c.Params("peopleId")
+	ipeopleId, err := strconv.ParseInt(peopleId, 10, 64)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.people.person.ParseInt"}).Error(err)
+		return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+			Message: "Por favor envie o id",
+		})
+	}
+
+	ctx := c.Context()
+	person, err := p.apps.People.GetOneByID(ctx, ipeopleId)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.people.person.GetOneByID"}).Error(err)
+		if errors.Is(err, peopleModel.ErrorPeopleNotFound) {
+			return c.Status(http.StatusNotFound).JSON(errorsP.ErrorsResponse{
+				Message: fmt.Sprintf("Pessoa (%d) não encontrada", ipeopleId),
+			})
+		}
+		return c.Status(http.StatusInternalServerError).JSON(errorsP.ErrorsResponse{
+			Message: "Aconteceu um erro interno..",
+		})
+	}
+
+	return c.Status(http.StatusOK).JSON(person)
+}
+
+// ListPeople godoc
+// @Summary      List people
+// @Description  get people
+// @Tags         people
+// @Accept       json
+// @Produce      json
+// @Param page query int false "page, starting at 1"
+// @Param limit query int false "limit, at most 100"
+// @Param name query string false "name"
+// @Success      200  {object}  peopleModel.ResponsePeople
+// @Failure      400  {object}  errorsP.ErrorsResponse
+// @Failure      404  {object}  errorsP.ErrorsResponse
+// @Failure      500  {object}  errorsP.ErrorsResponse
+// @Router       /people [get]
+func (p *apiImpl) people(c *fiber.Ctx) error {
+	ctx := c.Context()
+
+	ilimit, err := genericModel.ParseLimit(c.Query("limit"))
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.people.people.ParseLimit"}).Error(err)
+		return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+			Message: "Por favor envie o limit corretamente.",
+		})
+	}
+
+	ipage, err := genericModel.ParsePage(c.Query("page"))
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.people.people.ParsePage"}).Error(err)
+		return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+			Message: "Por favor envie o page corretamente.",
+		})
+	}
+
+	name := c.Query("name")
+
+	people, err := p.apps.People.GetAllPeople(ctx, ipage, ilimit, name)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.people.people.GetAllPeople"}).Error(err)
+		if errors.Is(err, peopleModel.ErrorPeopleNotFound) {
+			return c.Status(http.StatusNotFound).JSON(errorsP.ErrorsResponse{
+				Message: "Dados nao encontrados",
+			})
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(errorsP.ErrorsResponse{
+			Message: "Aconteceu um erro interno..",
+		})
+	}
+
+	total, err := p.apps.People.GetTotalPeople(ctx, name)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.people.people.GetTotalPeople"}).Error(err)
+		return c.Status(http.StatusInternalServerError).JSON(errorsP.ErrorsResponse{
+			Message: "Aconteceu um erro interno..",
+		})
+	}
+
+	return c.Status(http.StatusOK).JSON(peopleModel.ResponsePeople{
+		Data:               people,
+		ResponsePagination: genericModel.NewPagination(ipage, ilimit, *total),
+	})
+}
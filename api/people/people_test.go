@@ -0,0 +1,223 @@
+package people
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/danilotadeu/star_wars/app"
+	mockAppPeople "github.com/danilotadeu/star_wars/mock/app/people"
+	peopleModel "github.com/danilotadeu/star_wars/model/people"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestHandlerGetPeopleByID(t *testing.T) {
+	endpoint := "/people/:peopleId"
+	cases := map[string]struct {
+		InputParamID       string
+		ExpectedErr        error
+		ExpectedStatusCode int
+		PrepareMockApp     func(mockPeopleApp *mockAppPeople.MockApp)
+	}{
+		"should return success with people": {
+			InputParamID: "1",
+			ExpectedErr:  nil,
+			PrepareMockApp: func(mockPeopleApp *mockAppPeople.MockApp) {
+				mockPeopleApp.EXPECT().GetOneByID(gomock.Any(), int64(1)).Return(&peopleModel.PeopleDB{
+					ID:   1,
+					Name: "Luke Skywalker",
+				}, nil)
+			},
+			ExpectedStatusCode: http.StatusOK,
+		},
+		"should throw error with parse int": {
+			InputParamID: "xpto",
+			ExpectedErr:  nil,
+			PrepareMockApp: func(mockPeopleApp *mockAppPeople.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should return with people not found": {
+			InputParamID: "1",
+			ExpectedErr:  nil,
+			PrepareMockApp: func(mockPeopleApp *mockAppPeople.MockApp) {
+				mockPeopleApp.EXPECT().GetOneByID(gomock.Any(), gomock.Any()).Return(nil, peopleModel.ErrorPeopleNotFound)
+			},
+			ExpectedStatusCode: http.StatusNotFound,
+		},
+		"should throw error": {
+			InputParamID: "1",
+			ExpectedErr:  nil,
+			PrepareMockApp: func(mockPeopleApp *mockAppPeople.MockApp) {
+				mockPeopleApp.EXPECT().GetOneByID(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			ExpectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			mockPeopleApp := mockAppPeople.NewMockApp(ctrl)
+			cs.PrepareMockApp(mockPeopleApp)
+
+			h := apiImpl{
+				apps: &app.Container{
+					People: mockPeopleApp,
+				},
+			}
+
+			app := fiber.New()
+			app.Get(endpoint, h.person)
+			req := httptest.NewRequest(http.MethodGet, strings.ReplaceAll(endpoint, ":peopleId", cs.InputParamID), nil).WithContext(ctx)
+			req.Header.Set("Content-Type", fiber.MIMEApplicationJSON)
+			resp, err := app.Test(req, -1)
+			if err != nil {
+				t.Errorf("Error app.Test: %s", err.Error())
+				return
+			}
+
+			assert.Equal(t, cs.ExpectedErr, err)
+			assert.Equal(t, cs.ExpectedStatusCode, resp.StatusCode)
+		})
+	}
+}
+
+func TestHandlerGetPeople(t *testing.T) {
+	cases := map[string]struct {
+		InputPage          string
+		InputLimit         string
+		ExpectedErr        error
+		ExpectedStatusCode int
+		PrepareMockApp     func(mockPeopleApp *mockAppPeople.MockApp)
+	}{
+		"should return success with people": {
+			InputPage:   "1",
+			InputLimit:  "10",
+			ExpectedErr: nil,
+			PrepareMockApp: func(mockPeopleApp *mockAppPeople.MockApp) {
+				mockPeopleApp.EXPECT().GetAllPeople(gomock.Any(), int64(1), int64(10), "").Return([]*peopleModel.PeopleDB{
+					{
+						ID:   1,
+						Name: "Luke Skywalker",
+					},
+				}, nil)
+				var total int64 = 1
+				mockPeopleApp.EXPECT().GetTotalPeople(gomock.Any(), "").Return(&total, nil)
+			},
+			ExpectedStatusCode: http.StatusOK,
+		},
+		"should reduce the limit to the max": {
+			InputPage:   "2",
+			InputLimit:  "1000",
+			ExpectedErr: nil,
+			PrepareMockApp: func(mockPeopleApp *mockAppPeople.MockApp) {
+				mockPeopleApp.EXPECT().GetAllPeople(gomock.Any(), int64(2), int64(100), "").Return([]*peopleModel.PeopleDB{
+					{
+						ID:   101,
+						Name: "Luke Skywalker",
+					},
+				}, nil)
+				var total int64 = 101
+				mockPeopleApp.EXPECT().GetTotalPeople(gomock.Any(), "").Return(&total, nil)
+			},
+			ExpectedStatusCode: http.StatusOK,
+		},
+		"should throw error when get total people": {
+			InputPage:   "1",
+			InputLimit:  "10",
+			ExpectedErr: nil,
+			PrepareMockApp: func(mockPeopleApp *mockAppPeople.MockApp) {
+				mockPeopleApp.EXPECT().GetAllPeople(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*peopleModel.PeopleDB{
+					{
+						ID:   1,
+						Name: "Luke Skywalker",
+					},
+				}, nil)
+				mockPeopleApp.EXPECT().GetTotalPeople(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			ExpectedStatusCode: http.StatusInternalServerError,
+		},
+		"should throw error with page zero": {
+			ExpectedErr: nil,
+			InputPage:   "0",
+			PrepareMockApp: func(mockPeopleApp *mockAppPeople.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should throw error with limit zero": {
+			ExpectedErr: nil,
+			InputLimit:  "0",
+			PrepareMockApp: func(mockPeopleApp *mockAppPeople.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should throw error with parse int page": {
+			ExpectedErr: nil,
+			InputPage:   "xpto",
+			PrepareMockApp: func(mockPeopleApp *mockAppPeople.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should throw error with parse int limit": {
+			ExpectedErr: nil,
+			InputLimit:  "xpto",
+			PrepareMockApp: func(mockPeopleApp *mockAppPeople.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should return with people not found": {
+			ExpectedErr: nil,
+			PrepareMockApp: func(mockPeopleApp *mockAppPeople.MockApp) {
+				mockPeopleApp.EXPECT().GetAllPeople(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, peopleModel.ErrorPeopleNotFound)
+			},
+			ExpectedStatusCode: http.StatusNotFound,
+		},
+		"should throw error": {
+			ExpectedErr: nil,
+			PrepareMockApp: func(mockPeopleApp *mockAppPeople.MockApp) {
+				mockPeopleApp.EXPECT().GetAllPeople(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			ExpectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			mockPeopleApp := mockAppPeople.NewMockApp(ctrl)
+			cs.PrepareMockApp(mockPeopleApp)
+
+			h := apiImpl{
+				apps: &app.Container{
+					People: mockPeopleApp,
+				},
+			}
+			endpoint := "/people"
+			app := fiber.New()
+			app.Get(endpoint, h.people)
+
+			if len(cs.InputPage) > 0 && len(cs.InputLimit) > 0 {
+				endpoint += "?page=" + cs.InputPage + "&limit=" + cs.InputLimit
+			} else if len(cs.InputPage) > 0 {
+				endpoint += "?page=" + cs.InputPage
+			} else if len(cs.InputLimit) > 0 {
+				endpoint += "?limit=" + cs.InputLimit
+			}
+
+			req := httptest.NewRequest(http.MethodGet, endpoint, nil).WithContext(ctx)
+			req.Header.Set("Content-Type", fiber.MIMEApplicationJSON)
+			resp, err := app.Test(req, -1)
+			if err != nil {
+				t.Errorf("Error app.Test: %s", err.Error())
+				return
+			}
+
+			assert.Equal(t, cs.ExpectedErr, err)
+			assert.Equal(t, cs.ExpectedStatusCode, resp.StatusCode)
+		})
+	}
+}
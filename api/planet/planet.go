@@ -1,13 +1,16 @@
 package planet
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
 	"github.com/danilotadeu/star_wars/app"
 	errorsP "github.com/danilotadeu/star_wars/model/errors_handler"
+	filmModel "github.com/danilotadeu/star_wars/model/film"
 	genericModel "github.com/danilotadeu/star_wars/model/generic"
 	planetModel "github.com/danilotadeu/star_wars/model/planet"
 	"github.com/gofiber/fiber/v2"
@@ -25,8 +28,15 @@ func NewAPI(g fiber.Router, apps *app.Container) {
 	}
 
 	g.Get("/", api.planets)
+	g.Post("/", api.planetCreate)
+	// registered before /:planetId, otherwise "deleted" would be parsed as an id
+	g.Get("/deleted", api.planetsDeleted)
 	g.Get("/:planetId", api.planet)
+	g.Put("/:planetId", api.planetUpdate)
+	g.Patch("/:planetId", api.planetPatch)
+	g.Get("/:planetId/films", api.planetFilms)
 	g.Delete("/:planetId", api.planetDelete)
+	g.Post("/:planetId/restore", api.planetRestore)
 }
 
 // ShowPlanet godoc
@@ -68,6 +78,215 @@ func (p *apiImpl) planet(c *fiber.Ctx) error {
 	return c.Status(http.StatusOK).JSON(planet)
 }
 
+// CreatePlanet godoc
+// @Summary      Create a planet
+// @Description  create a planet, optionally linked to films
+// @Tags         planets
+// @Accept       json
+// @Produce      json
+// @Param        planet  body      planetModel.PlanetRequest  true  "Planet"
+// @Success      201  {object}  planetModel.PlanetDB
+// @Header       201  {string}  Location  "/api/planets/{id}"
+// @Failure      400  {object}  errorsP.ErrorsResponse
+// @Failure      409  {object}  errorsP.ErrorsResponse
+// @Failure      500  {object}  errorsP.ErrorsResponse
+// @Router       /planets [post]
+func (p *apiImpl) planetCreate(c *fiber.Ctx) error {
+	var body planetModel.PlanetRequest
+	err := c.BodyParser(&body)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.planet.planetCreate.BodyParser"}).Error(err)
+		return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+			Message: "Por favor envie o planeta corretamente.",
+		})
+	}
+
+	err = body.Validate()
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.planet.planetCreate.Validate"}).Error(err)
+		return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+			Message: invalidFieldMessage(err),
+		})
+	}
+
+	ctx := c.Context()
+	planet, err := p.apps.Planet.CreatePlanet(ctx, body)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.planet.planetCreate.CreatePlanet"}).Error(err)
+		if errors.Is(err, planetModel.ErrorPlanetAlreadyExists) {
+			return c.Status(http.StatusConflict).JSON(errorsP.ErrorsResponse{
+				Message: fmt.Sprintf("Planeta (%s) já existe", body.Name),
+			})
+		}
+		if errors.Is(err, filmModel.ErrorFilmNotFound) {
+			return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+				Message: "Por favor envie o film_ids corretamente.",
+			})
+		}
+		return c.Status(http.StatusInternalServerError).JSON(errorsP.ErrorsResponse{
+			Message: "Aconteceu um erro interno..",
+		})
+	}
+
+	c.Location(fmt.Sprintf("%s/%d", strings.TrimRight(c.Path(), "/"), planet.ID))
+	return c.Status(http.StatusCreated).JSON(planet)
+}
+
+// UpdatePlanet godoc
+// @Summary      Replace a planet
+// @Description  replace every attribute and the film links of a planet
+// @Tags         planets
+// @Accept       json
+// @Produce      json
+// @Param        id      path      int                        true  "Planet ID"
+// @Param        planet  body      planetModel.PlanetRequest  true  "Planet"
+// @Success      200  {object}  planetModel.PlanetDB
+// @Failure      400  {object}  errorsP.ErrorsResponse
+// @Failure      404  {object}  errorsP.ErrorsResponse
+// @Failure      409  {object}  errorsP.ErrorsResponse
+// @Failure      500  {object}  errorsP.ErrorsResponse
+// @Router       /planets/{id} [put]
+func (p *apiImpl) planetUpdate(c *fiber.Ctx) error {
+	planetId := c.Params("planetId")
+	iplanetId, err := strconv.ParseInt(planetId, 10, 64)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.planet.planetUpdate.ParseInt"}).Error(err)
+		return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+			Message: "Por favor envie o id",
+		})
+	}
+
+	var body planetModel.PlanetRequest
+	err = c.BodyParser(&body)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.planet.planetUpdate.BodyParser"}).Error(err)
+		return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+			Message: "Por favor envie o planeta corretamente.",
+		})
+	}
+
+	err = body.Validate()
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.planet.planetUpdate.Validate"}).Error(err)
+		return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+			Message: invalidFieldMessage(err),
+		})
+	}
+
+	ctx := c.Context()
+	planet, err := p.apps.Planet.UpdatePlanet(ctx, iplanetId, body)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.planet.planetUpdate.UpdatePlanet"}).Error(err)
+		if errors.Is(err, planetModel.ErrorPlanetNotFound) {
+			return c.Status(http.StatusNotFound).JSON(errorsP.ErrorsResponse{
+				Message: fmt.Sprintf("Planeta (%d) não encontrado", iplanetId),
+			})
+		}
+		if errors.Is(err, planetModel.ErrorPlanetAlreadyExists) {
+			return c.Status(http.StatusConflict).JSON(errorsP.ErrorsResponse{
+				Message: fmt.Sprintf("Planeta (%s) já existe", body.Name),
+			})
+		}
+		if errors.Is(err, filmModel.ErrorFilmNotFound) {
+			return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+				Message: "Por favor envie o film_ids corretamente.",
+			})
+		}
+		return c.Status(http.StatusInternalServerError).JSON(errorsP.ErrorsResponse{
+			Message: "Aconteceu um erro interno..",
+		})
+	}
+
+	return c.Status(http.StatusOK).JSON(planet)
+}
+
+// PatchPlanet godoc
+// @Summary      Update a planet
+// @Description  update some attributes of a planet with a JSON Merge Patch, null removes the value and film_ids replaces the film links
+// @Tags         planets
+// @Accept       json
+// @Accept       application/merge-patch+json
+// @Produce      json
+// @Param        id     path      int                        true  "Planet ID"
+// @Param        patch  body      planetModel.PlanetRequest  true  "Fields to update"
+// @Success      200  {object}  planetModel.PlanetDB
+// @Failure      400  {object}  errorsP.ErrorsResponse
+// @Failure      404  {object}  errorsP.ErrorsResponse
+// @Failure      409  {object}  errorsP.ErrorsResponse
+// @Failure      500  {object}  errorsP.ErrorsResponse
+// @Router       /planets/{id} [patch]
+func (p *apiImpl) planetPatch(c *fiber.Ctx) error {
+	planetId := c.Params("planetId")
+	iplanetId, err := strconv.ParseInt(planetId, 10, 64)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.planet.planetPatch.ParseInt"}).Error(err)
+		return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+			Message: "Por favor envie o id",
+		})
+	}
+
+	var patch planetModel.PlanetPatch
+	err = json.Unmarshal(c.Body(), &patch)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.planet.planetPatch.Unmarshal"}).Error(err)
+		return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+			Message: "Por favor envie o planeta corretamente.",
+		})
+	}
+
+	ctx := c.Context()
+	planet, err := p.apps.Planet.PatchPlanet(ctx, iplanetId, patch)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.planet.planetPatch.PatchPlanet"}).Error(err)
+		var invalidField *planetModel.InvalidFieldError
+		if errors.As(err, &invalidField) {
+			return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+				Message: invalidFieldMessage(err),
+			})
+		}
+		if errors.Is(err, planetModel.ErrorPlanetNotFound) {
+			return c.Status(http.StatusNotFound).JSON(errorsP.ErrorsResponse{
+				Message: fmt.Sprintf("Planeta (%d) não encontrado", iplanetId),
+			})
+		}
+		if errors.Is(err, planetModel.ErrorPlanetAlreadyExists) {
+			return c.Status(http.StatusConflict).JSON(errorsP.ErrorsResponse{
+				Message: "Já existe um planeta com esse nome",
+			})
+		}
+		if errors.Is(err, filmModel.ErrorFilmNotFound) {
+			return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+				Message: "Por favor envie o film_ids corretamente.",
+			})
+		}
+		return c.Status(http.StatusInternalServerError).JSON(errorsP.ErrorsResponse{
+			Message: "Aconteceu um erro interno..",
+		})
+	}
+
+	return c.Status(http.StatusOK).JSON(planet)
+}
+
+// invalidFieldMessage tell the client which field of the planet is not valid..
+func invalidFieldMessage(err error) string {
+	var invalidField *planetModel.InvalidFieldError
+	if errors.As(err, &invalidField) {
+		return fmt.Sprintf("Por favor envie o %s corretamente.", invalidField.Field)
+	}
+
+	return "Por favor envie o planeta corretamente."
+}
+
+// invalidSortMessage tell which sort field was refused and the ones that can be used..
+func invalidSortMessage(err error) string {
+	var invalidSort *planetModel.InvalidSortError
+	if errors.As(err, &invalidSort) {
+		return fmt.Sprintf("Não é possível ordenar por (%s). Envie no sort campos distintos entre %s, com - na frente para a ordem decrescente.", invalidSort.Field, strings.Join(planetModel.SortFields, ", "))
+	}
+
+	return "Por favor envie o sort corretamente."
+}
+
 // DeletePlanet godoc
 // @Summary      Delete a planet
 // @Description  delete planet by ID
@@ -107,15 +326,135 @@ func (p *apiImpl) planetDelete(c *fiber.Ctx) error {
 	return c.Status(http.StatusNoContent).JSON(true)
 }
 
+// RestorePlanet godoc
+// @Summary      Restore a planet
+// @Description  restore a deleted planet and its film links
+// @Tags         planets
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Planet ID"
+// @Success      200  {object}  planetModel.PlanetDB
+// @Failure      400  {object}  errorsP.ErrorsResponse
+// @Failure      404  {object}  errorsP.ErrorsResponse
+// @Failure      409  {object}  errorsP.ErrorsResponse
+// @Failure      500  {object}  errorsP.ErrorsResponse
+// @Router       /planets/{id}/restore [post]
+func (p *apiImpl) planetRestore(c *fiber.Ctx) error {
+	planetId := c.Params("planetId")
+	iplanetId, err := strconv.ParseInt(planetId, 10, 64)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.planet.planetRestore.ParseInt"}).Error(err)
+		return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+			Message: "Por favor envie o id",
+		})
+	}
+
+	ctx := c.Context()
+	planet, err := p.apps.Planet.Restore(ctx, iplanetId)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.planet.planetRestore.Restore"}).Error(err)
+		if errors.Is(err, planetModel.ErrorPlanetNotFound) {
+			return c.Status(http.StatusNotFound).JSON(errorsP.ErrorsResponse{
+				Message: fmt.Sprintf("Planeta excluído (%d) não encontrado", iplanetId),
+			})
+		}
+		if errors.Is(err, planetModel.ErrorPlanetAlreadyExists) {
+			return c.Status(http.StatusConflict).JSON(errorsP.ErrorsResponse{
+				Message: fmt.Sprintf("Outro planeta já usa o nome do planeta (%d)", iplanetId),
+			})
+		}
+		return c.Status(http.StatusInternalServerError).JSON(errorsP.ErrorsResponse{
+			Message: "Aconteceu um erro interno..",
+		})
+	}
+
+	return c.Status(http.StatusOK).JSON(planet)
+}
+
+// ListDeletedPlanets godoc
+// @Summary      List deleted planets
+// @Description  get the deleted planets, the last deleted first
+// @Tags         planets
+// @Accept       json
+// @Produce      json
+// @Param page query int false "page, starting at 1"
+// @Param limit query int false "limit, at most 100"
+// @Success      200  {object}  planetModel.ResponsePlanets
+// @Failure      400  {object}  errorsP.ErrorsResponse
+// @Failure      404  {object}  errorsP.ErrorsResponse
+// @Failure      500  {object}  errorsP.ErrorsResponse
+// @Router       /planets/deleted [get]
+func (p *apiImpl) planetsDeleted(c *fiber.Ctx) error {
+	ctx := c.Context()
+
+	ilimit, err := genericModel.ParseLimit(c.Query("limit"))
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.planet.planetsDeleted.ParseLimit"}).Error(err)
+		return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+			Message: "Por favor envie o limit corretamente.",
+		})
+	}
+
+	ipage, err := genericModel.ParsePage(c.Query("page"))
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.planet.planetsDeleted.ParsePage"}).Error(err)
+		return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+			Message: "Por favor envie o page corretamente.",
+		})
+	}
+
+	planets, err := p.apps.Planet.GetDeletedPlanets(ctx, ipage, ilimit)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.planet.planetsDeleted.GetDeletedPlanets"}).Error(err)
+		if errors.Is(err, planetModel.ErrorPlanetNotFound) {
+			return c.Status(http.StatusNotFound).JSON(errorsP.ErrorsResponse{
+				Message: "Dados nao encontrados",
+			})
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(errorsP.ErrorsResponse{
+			Message: "Aconteceu um erro interno..",
+		})
+	}
+
+	total, err := p.apps.Planet.GetTotalDeletedPlanets(ctx)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.planet.planetsDeleted.GetTotalDeletedPlanets"}).Error(err)
+		return c.Status(http.StatusInternalServerError).JSON(errorsP.ErrorsResponse{
+			Message: "Aconteceu um erro interno..",
+		})
+	}
+
+	return c.Status(http.StatusOK).JSON(planetModel.ResponsePlanets{
+		Data:               planets,
+		ResponsePagination: genericModel.NewPagination(ipage, ilimit, *total),
+	})
+}
+
 // ListPlanets godoc
 // @Summary      List planets
-// @Description  get planets
+// @Description  get planets, by page or, when the cursor is sent, by cursor with the pagination of ResponsePlanetsByCursor
 // @Tags         planets
 // @Accept       json
 // @Produce      json
-// @Param page query int false "page"
-// @Param limit query int false "limit"
+// @Param page query int false "page, starting at 1"
+// @Param limit query int false "limit, at most 100"
+// @Param cursor query string false "next_cursor or prev_cursor of a previous response, instead of page"
+// @Param sort query string false "comma separated fields, - for descending: name, climate, terrain, rotation_period, orbital_period, diameter, surface_water, population, created_at, film_count"
 // @Param name query string false "name"
+// @Param climate query string false "climates the planet must have, comma separated"
+// @Param terrain query string false "terrains the planet must have, comma separated"
+// @Param film_id query int false "film ID"
+// @Param population_gte query number false "population greater than or equal (also _gt, _lt, _lte)"
+// @Param population_between query string false "population between min,max"
+// @Param diameter_lt query number false "diameter less than (also _gt, _gte, _lte)"
+// @Param diameter_between query string false "diameter between min,max"
+// @Param orbital_period_gte query number false "orbital period greater than or equal (also _gt, _lt, _lte)"
+// @Param orbital_period_between query string false "orbital period between min,max"
+// @Param rotation_period_gte query number false "rotation period greater than or equal (also _gt, _lt, _lte)"
+// @Param rotation_period_between query string false "rotation period between min,max"
+// @Param surface_water_gte query number false "surface water greater than or equal (also _gt, _lt, _lte)"
+// @Param surface_water_between query string false "surface water between min,max"
 // @Success      200  {object}  planetModel.ResponsePlanets
 // @Failure      400  {object}  errorsP.ErrorsResponse
 // @Failure      404  {object}  errorsP.ErrorsResponse
@@ -124,36 +463,97 @@ func (p *apiImpl) planetDelete(c *fiber.Ctx) error {
 func (p *apiImpl) planets(c *fiber.Ctx) error {
 	ctx := c.Context()
 
-	limit := c.Query("limit")
-	var ilimit int64 = 10
-	if len(limit) > 0 {
-		limitConv, err := strconv.ParseInt(limit, 10, 64)
+	ilimit, err := genericModel.ParseLimit(c.Query("limit"))
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.planet.planets.ParseLimit"}).Error(err)
+		return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+			Message: "Por favor envie o limit corretamente.",
+		})
+	}
+
+	page := c.Query("page")
+	ipage, err := genericModel.ParsePage(page)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.planet.planets.ParsePage"}).Error(err)
+		return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+			Message: "Por favor envie o page corretamente.",
+		})
+	}
+
+	var cursor *genericModel.Cursor
+	if cursorValue := c.Query("cursor"); len(cursorValue) > 0 {
+		if len(page) > 0 {
+			return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+				Message: "Por favor envie o page ou o cursor, não os dois.",
+			})
+		}
+
+		cursorConv, err := genericModel.DecodeCursor(cursorValue)
 		if err != nil {
-			logrus.WithFields(logrus.Fields{"trace": "api.planet.planets.ParseInt.limit"}).Error(err)
+			logrus.WithFields(logrus.Fields{"trace": "api.planet.planets.DecodeCursor"}).Error(err)
 			return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
-				Message: "Por favor envie o limit corretamente.",
+				Message: "Por favor envie o cursor corretamente.",
 			})
 		}
-		ilimit = limitConv
+		cursor = cursorConv
 	}
 
-	page := c.Query("page")
-	var ipage int64
+	sorts, err := planetModel.ParseSort(c.Query("sort"))
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.planet.planets.ParseSort"}).Error(err)
+		return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+			Message: invalidSortMessage(err),
+		})
+	}
 
-	if len(page) > 0 {
-		pageConv, err := strconv.ParseInt(page, 10, 64)
-		if err != nil {
-			logrus.WithFields(logrus.Fields{"trace": "api.planet.planets.ParseInt.page"}).Error(err)
+	var filmID int64
+	if film := c.Query("film_id"); len(film) > 0 {
+		filmConv, err := strconv.ParseInt(film, 10, 64)
+		if err != nil || filmConv < 1 {
+			logrus.WithFields(logrus.Fields{"trace": "api.planet.planets.ParseInt.film_id"}).Error(fmt.Errorf("Invalid film_id %s", film))
 			return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
-				Message: "Por favor envie o page corretamente.",
+				Message: "Por favor envie o film_id corretamente.",
 			})
 		}
-		ipage = pageConv
+		filmID = filmConv
 	}
 
-	name := c.Query("name")
+	filter := planetModel.Filter{
+		Name:     c.Query("name"),
+		Climates: planetModel.ParseTokens(c.Query("climate")),
+		Terrains: planetModel.ParseTokens(c.Query("terrain")),
+		FilmID:   filmID,
+		Sort:     sorts,
+	}
+
+	var errRange error
+	c.Context().QueryArgs().VisitAll(func(key, value []byte) {
+		if errRange != nil {
+			return
+		}
+
+		rangeFilter, err := planetModel.ParseRange(string(key), string(value))
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "api.planet.planets.ParseRange"}).Error(err)
+			errRange = fmt.Errorf("Por favor envie o %s corretamente.", key)
+			return
+		}
+
+		if rangeFilter != nil {
+			filter.Ranges = append(filter.Ranges, *rangeFilter)
+		}
+	})
+	if errRange != nil {
+		return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+			Message: errRange.Error(),
+		})
+	}
+
+	if cursor != nil {
+		return p.planetsByCursor(c, cursor, ilimit, filter)
+	}
 
-	planets, err := p.apps.Planet.GetAllPlanets(ctx, ipage, ilimit, name)
+	planets, err := p.apps.Planet.GetAllPlanets(ctx, ipage, ilimit, filter)
 	if err != nil {
 		logrus.WithFields(logrus.Fields{"trace": "api.planet.planets.GetAllPlanets"}).Error(err)
 		if errors.Is(err, planetModel.ErrorPlanetNotFound) {
@@ -167,33 +567,130 @@ func (p *apiImpl) planets(c *fiber.Ctx) error {
 		})
 	}
 
-	nextPage, previousPage := genericModel.MakePagination(ipage)
+	total, err := p.apps.Planet.GetTotalPlanets(ctx, filter)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.planet.planets.GetTotalPlanets"}).Error(err)
+		return c.Status(http.StatusInternalServerError).JSON(errorsP.ErrorsResponse{
+			Message: "Aconteceu um erro interno..",
+		})
+	}
+
+	return c.Status(http.StatusOK).JSON(planetModel.ResponsePlanets{
+		Data:               planets,
+		ResponsePagination: genericModel.NewPagination(ipage, ilimit, *total).WithCursors(planets[0].Cursor(filter.Sort), planets[len(planets)-1].Cursor(filter.Sort)),
+	})
+}
 
-	_, err = p.apps.Planet.GetAllPlanets(ctx, *nextPage, ilimit, name)
+// planetsByCursor answer the list of planets on the keyset pagination..
+func (p *apiImpl) planetsByCursor(c *fiber.Ctx, cursor *genericModel.Cursor, limit int64, filter planetModel.Filter) error {
+	ctx := c.Context()
+
+	planets, pagination, err := p.apps.Planet.GetPlanetsByCursor(ctx, cursor, limit, filter)
 	if err != nil {
-		if !errors.Is(err, planetModel.ErrorPlanetNotFound) {
-			logrus.WithFields(logrus.Fields{"trace": "api.planet.planets.GetAllPlanets_1"}).Error(err)
-			return c.Status(http.StatusInternalServerError).JSON(errorsP.ErrorsResponse{
-				Message: "Aconteceu um erro interno..",
+		logrus.WithFields(logrus.Fields{"trace": "api.planet.planetsByCursor.GetPlanetsByCursor"}).Error(err)
+		if errors.Is(err, planetModel.ErrorPlanetNotFound) {
+			return c.Status(http.StatusNotFound).JSON(errorsP.ErrorsResponse{
+				Message: "Dados nao encontrados",
 			})
 		}
-		nextPage = nil
+		if errors.Is(err, genericModel.ErrorInvalidCursor) {
+			return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+				Message: "Por favor envie o cursor corretamente.",
+			})
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(errorsP.ErrorsResponse{
+			Message: "Aconteceu um erro interno..",
+		})
 	}
 
-	total, err := p.apps.Planet.GetTotalPlanets(ctx)
+	total, err := p.apps.Planet.GetTotalPlanets(ctx, filter)
 	if err != nil {
-		logrus.WithFields(logrus.Fields{"trace": "api.planet.planets.GetTotalPlanets"}).Error(err)
+		logrus.WithFields(logrus.Fields{"trace": "api.planet.planetsByCursor.GetTotalPlanets"}).Error(err)
 		return c.Status(http.StatusInternalServerError).JSON(errorsP.ErrorsResponse{
 			Message: "Aconteceu um erro interno..",
 		})
 	}
+	pagination.Count = *total
 
-	return c.Status(http.StatusOK).JSON(planetModel.ResponsePlanets{
-		Data: planets,
-		ResponsePagination: genericModel.Pagination{
-			Count:        *total,
-			NextPage:     nextPage,
-			PreviousPage: previousPage,
-		},
+	return c.Status(http.StatusOK).JSON(planetModel.ResponsePlanetsByCursor{
+		Data:               planets,
+		ResponsePagination: *pagination,
+	})
+}
+
+// ListPlanetFilms godoc
+// @Summary      List the films of a planet
+// @Description  get films by planet ID
+// @Tags         planets
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Planet ID"
+// @Param page query int false "page, starting at 1"
+// @Param limit query int false "limit, at most 100"
+// @Success      200  {object}  filmModel.ResponseFilms
+// @Failure      400  {object}  errorsP.ErrorsResponse
+// @Failure      404  {object}  errorsP.ErrorsResponse
+// @Failure      500  {object}  errorsP.ErrorsResponse
+// @Router       /planets/{id}/films [get]
+func (p *apiImpl) planetFilms(c *fiber.Ctx) error {
+	planetId := c.Params("planetId")
+	iplanetId, err := strconv.ParseInt(planetId, 10, 64)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.planet.planetFilms.ParseInt"}).Error(err)
+		return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+			Message: "Por favor envie o id",
+		})
+	}
+
+	ctx := c.Context()
+
+	ilimit, err := genericModel.ParseLimit(c.Query("limit"))
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.planet.planetFilms.ParseLimit"}).Error(err)
+		return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+			Message: "Por favor envie o limit corretamente.",
+		})
+	}
+
+	ipage, err := genericModel.ParsePage(c.Query("page"))
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.planet.planetFilms.ParsePage"}).Error(err)
+		return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+			Message: "Por favor envie o page corretamente.",
+		})
+	}
+
+	films, err := p.apps.Planet.GetFilmsByPlanetID(ctx, iplanetId, ipage, ilimit)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.planet.planetFilms.GetFilmsByPlanetID"}).Error(err)
+		if errors.Is(err, planetModel.ErrorPlanetNotFound) {
+			return c.Status(http.StatusNotFound).JSON(errorsP.ErrorsResponse{
+				Message: fmt.Sprintf("Planeta (%d) não encontrado", iplanetId),
+			})
+		}
+
+		if errors.Is(err, filmModel.ErrorFilmNotFound) {
+			return c.Status(http.StatusNotFound).JSON(errorsP.ErrorsResponse{
+				Message: "Dados nao encontrados",
+			})
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(errorsP.ErrorsResponse{
+			Message: "Aconteceu um erro interno..",
+		})
+	}
+
+	total, err := p.apps.Planet.GetTotalFilmsByPlanetID(ctx, iplanetId)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "api.planet.planetFilms.GetTotalFilmsByPlanetID"}).Error(err)
+		return c.Status(http.StatusInternalServerError).JSON(errorsP.ErrorsResponse{
+			Message: "Aconteceu um erro interno..",
+		})
+	}
+
+	return c.Status(http.StatusOK).JSON(filmModel.ResponseFilms{
+		Data:               films,
+		ResponsePagination: genericModel.NewPagination(ipage, ilimit, *total),
 	})
 }
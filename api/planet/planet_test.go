@@ -10,6 +10,8 @@ import (
 
 	"github.com/danilotadeu/star_wars/app"
 	mockAppPlanet "github.com/danilotadeu/star_wars/mock/app/planet"
+	filmModel "github.com/danilotadeu/star_wars/model/film"
+	genericModel "github.com/danilotadeu/star_wars/model/generic"
 	planetModel "github.com/danilotadeu/star_wars/model/planet"
 	"github.com/gofiber/fiber/v2"
 	"github.com/golang/mock/gomock"
@@ -158,19 +160,21 @@ func TestHandlerGetPlanetByID(t *testing.T) {
 }
 
 func TestHandlerGetPlanets(t *testing.T) {
+	cursor := genericModel.Cursor{ID: 10}
 	cases := map[string]struct {
 		InputPage          string
 		InputLimit         string
+		InputQuery         string
 		ExpectedErr        error
 		ExpectedStatusCode int
 		PrepareMockApp     func(mockPlanetApp *mockAppPlanet.MockApp)
 	}{
 		"should return success with planet": {
-			InputPage:   "1",
+			InputPage:   "2",
 			InputLimit:  "10",
 			ExpectedErr: nil,
 			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
-				mockPlanetApp.EXPECT().GetAllPlanets(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*planetModel.PlanetDB{
+				mockPlanetApp.EXPECT().GetAllPlanets(gomock.Any(), int64(2), int64(10), planetModel.Filter{}).Return([]*planetModel.PlanetDB{
 					{
 						ID:      1,
 						Name:    "Planet 1",
@@ -178,28 +182,172 @@ func TestHandlerGetPlanets(t *testing.T) {
 						Terrain: "Terrain 1",
 					},
 				}, nil)
-				mockPlanetApp.EXPECT().GetAllPlanets(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, planetModel.ErrorPlanetNotFound)
+				var total int64 = 11
+				mockPlanetApp.EXPECT().GetTotalPlanets(gomock.Any(), planetModel.Filter{}).Return(&total, nil)
+			},
+			ExpectedStatusCode: http.StatusOK,
+		},
+		"should use the first page and the default limit": {
+			ExpectedErr: nil,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+				mockPlanetApp.EXPECT().GetAllPlanets(gomock.Any(), int64(1), int64(10), gomock.Any()).Return([]*planetModel.PlanetDB{
+					{
+						ID:   1,
+						Name: "Planet 1",
+					},
+				}, nil)
 				var total int64 = 1
-				mockPlanetApp.EXPECT().GetTotalPlanets(gomock.Any()).Return(&total, nil)
+				mockPlanetApp.EXPECT().GetTotalPlanets(gomock.Any(), gomock.Any()).Return(&total, nil)
 			},
 			ExpectedStatusCode: http.StatusOK,
 		},
-		"should throw error when get planets to paginate": {
-			InputPage:   "1",
-			InputLimit:  "10",
+		"should cap the limit": {
+			InputLimit:  "1000",
 			ExpectedErr: nil,
 			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
-				mockPlanetApp.EXPECT().GetAllPlanets(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*planetModel.PlanetDB{
+				mockPlanetApp.EXPECT().GetAllPlanets(gomock.Any(), int64(1), int64(100), gomock.Any()).Return([]*planetModel.PlanetDB{
+					{
+						ID:   1,
+						Name: "Planet 1",
+					},
+				}, nil)
+				var total int64 = 1
+				mockPlanetApp.EXPECT().GetTotalPlanets(gomock.Any(), gomock.Any()).Return(&total, nil)
+			},
+			ExpectedStatusCode: http.StatusOK,
+		},
+		"should count the planets with the name filter": {
+			InputQuery:  "name=Tatooine",
+			ExpectedErr: nil,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+				filter := planetModel.Filter{Name: "Tatooine"}
+				mockPlanetApp.EXPECT().GetAllPlanets(gomock.Any(), gomock.Any(), gomock.Any(), filter).Return([]*planetModel.PlanetDB{
+					{
+						ID:   1,
+						Name: "Tatooine",
+					},
+				}, nil)
+				var total int64 = 1
+				mockPlanetApp.EXPECT().GetTotalPlanets(gomock.Any(), filter).Return(&total, nil)
+			},
+			ExpectedStatusCode: http.StatusOK,
+		},
+		"should return success with cursor": {
+			InputLimit:  "5",
+			InputQuery:  "name=oo&cursor=" + cursor.Encode(),
+			ExpectedErr: nil,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+				filter := planetModel.Filter{Name: "oo"}
+				mockPlanetApp.EXPECT().GetPlanetsByCursor(gomock.Any(), &cursor, int64(5), filter).Return([]*planetModel.PlanetDB{
+					{
+						ID:   11,
+						Name: "Tatooine",
+					},
+				}, &genericModel.CursorPagination{Limit: 5}, nil)
+				var total int64 = 6
+				mockPlanetApp.EXPECT().GetTotalPlanets(gomock.Any(), filter).Return(&total, nil)
+			},
+			ExpectedStatusCode: http.StatusOK,
+		},
+		"should return success sorted by fields": {
+			InputQuery:  "sort=name,-film_count",
+			ExpectedErr: nil,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+				filter := planetModel.Filter{
+					Sort: []planetModel.Sort{{Field: "name"}, {Field: "film_count", Desc: true}},
+				}
+				mockPlanetApp.EXPECT().GetAllPlanets(gomock.Any(), int64(1), int64(10), filter).Return([]*planetModel.PlanetDB{
+					{
+						ID:   1,
+						Name: "Alderaan",
+					},
+				}, nil)
+				var total int64 = 1
+				mockPlanetApp.EXPECT().GetTotalPlanets(gomock.Any(), filter).Return(&total, nil)
+			},
+			ExpectedStatusCode: http.StatusOK,
+		},
+		"should return success with climate, terrain and film filters": {
+			InputQuery:  "name=oo&climate=arid&terrain=desert,rocky%20canyons&film_id=1",
+			ExpectedErr: nil,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+				filter := planetModel.Filter{
+					Name:     "oo",
+					Climates: []string{"arid"},
+					Terrains: []string{"desert", "rocky canyons"},
+					FilmID:   1,
+				}
+				mockPlanetApp.EXPECT().GetAllPlanets(gomock.Any(), int64(1), int64(10), filter).Return([]*planetModel.PlanetDB{
 					{
 						ID:      1,
-						Name:    "Planet 1",
-						Climate: "Climate 1",
-						Terrain: "Terrain 1",
+						Name:    "Tatooine",
+						Climate: "arid",
+						Terrain: "desert",
 					},
 				}, nil)
-				mockPlanetApp.EXPECT().GetAllPlanets(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+				var total int64 = 1
+				mockPlanetApp.EXPECT().GetTotalPlanets(gomock.Any(), filter).Return(&total, nil)
 			},
-			ExpectedStatusCode: http.StatusInternalServerError,
+			ExpectedStatusCode: http.StatusOK,
+		},
+		"should throw error with invalid film_id": {
+			InputQuery:  "film_id=xpto",
+			ExpectedErr: nil,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should throw error with film_id zero": {
+			InputQuery:  "film_id=0",
+			ExpectedErr: nil,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should throw error with sort field out of the allowlist": {
+			InputQuery:  "sort=-gravity",
+			ExpectedErr: nil,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should throw error with repeated sort field": {
+			InputQuery:  "sort=name,-name",
+			ExpectedErr: nil,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should throw error with invalid cursor": {
+			InputQuery:  "cursor=xpto",
+			ExpectedErr: nil,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should throw error when the cursor is not of planets": {
+			InputQuery:  "cursor=" + cursor.Encode(),
+			ExpectedErr: nil,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+				mockPlanetApp.EXPECT().GetPlanetsByCursor(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil, genericModel.ErrorInvalidCursor)
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should throw error with page and cursor": {
+			InputPage:   "2",
+			InputQuery:  "cursor=" + cursor.Encode(),
+			ExpectedErr: nil,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should return with planets not found after the cursor": {
+			InputQuery:  "cursor=" + cursor.Encode(),
+			ExpectedErr: nil,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+				mockPlanetApp.EXPECT().GetPlanetsByCursor(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil, planetModel.ErrorPlanetNotFound)
+			},
+			ExpectedStatusCode: http.StatusNotFound,
 		},
 		"should throw error when get total planets": {
 			InputPage:   "1",
@@ -214,7 +362,21 @@ func TestHandlerGetPlanets(t *testing.T) {
 						Terrain: "Terrain 1",
 					},
 				}, nil)
-				mockPlanetApp.EXPECT().GetAllPlanets(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*planetModel.PlanetDB{
+				mockPlanetApp.EXPECT().GetTotalPlanets(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			ExpectedStatusCode: http.StatusInternalServerError,
+		},
+		"should return success with range filters": {
+			InputQuery:  "population_gte=1000&diameter_between=1000,5000&gravity_gte=1",
+			ExpectedErr: nil,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+				filter := planetModel.Filter{
+					Ranges: []planetModel.Range{
+						{Field: "population", Operator: planetModel.OperatorGreaterOrEqual, Values: []float64{1000}},
+						{Field: "diameter", Operator: planetModel.OperatorBetween, Values: []float64{1000, 5000}},
+					},
+				}
+				mockPlanetApp.EXPECT().GetAllPlanets(gomock.Any(), gomock.Any(), gomock.Any(), filter).Return([]*planetModel.PlanetDB{
 					{
 						ID:      1,
 						Name:    "Planet 1",
@@ -222,9 +384,24 @@ func TestHandlerGetPlanets(t *testing.T) {
 						Terrain: "Terrain 1",
 					},
 				}, nil)
-				mockPlanetApp.EXPECT().GetTotalPlanets(gomock.Any()).Return(nil, fmt.Errorf("error"))
+				var total int64 = 1
+				mockPlanetApp.EXPECT().GetTotalPlanets(gomock.Any(), filter).Return(&total, nil)
 			},
-			ExpectedStatusCode: http.StatusInternalServerError,
+			ExpectedStatusCode: http.StatusOK,
+		},
+		"should throw error with invalid range value": {
+			InputQuery:  "population_gte=xpto",
+			ExpectedErr: nil,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should throw error with between without two values": {
+			InputQuery:  "orbital_period_between=300",
+			ExpectedErr: nil,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
 		},
 		"should throw error with parse int page": {
 			ExpectedErr: nil,
@@ -240,6 +417,20 @@ func TestHandlerGetPlanets(t *testing.T) {
 			},
 			ExpectedStatusCode: http.StatusBadRequest,
 		},
+		"should throw error with page zero": {
+			ExpectedErr: nil,
+			InputPage:   "0",
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should throw error with negative limit": {
+			ExpectedErr: nil,
+			InputLimit:  "-1",
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
 		"should return with planet not found": {
 			ExpectedErr: nil,
 			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
@@ -278,6 +469,14 @@ func TestHandlerGetPlanets(t *testing.T) {
 				endpoint += "?limit=" + cs.InputLimit
 			}
 
+			if len(cs.InputQuery) > 0 {
+				if strings.Contains(endpoint, "?") {
+					endpoint += "&" + cs.InputQuery
+				} else {
+					endpoint += "?" + cs.InputQuery
+				}
+			}
+
 			req := httptest.NewRequest(http.MethodGet, endpoint, nil).WithContext(ctx)
 			req.Header.Set("Content-Type", fiber.MIMEApplicationJSON)
 			resp, err := app.Test(req, -1)
@@ -291,3 +490,555 @@ func TestHandlerGetPlanets(t *testing.T) {
 		})
 	}
 }
+
+func TestHandlerGetPlanetFilms(t *testing.T) {
+	endpoint := "/planets/:planetId/films"
+	cases := map[string]struct {
+		InputParamID       string
+		InputQuery         string
+		ExpectedErr        error
+		ExpectedStatusCode int
+		PrepareMockApp     func(mockPlanetApp *mockAppPlanet.MockApp)
+	}{
+		"should return success": {
+			InputParamID: "1",
+			InputQuery:   "?page=1&limit=10",
+			ExpectedErr:  nil,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+				mockPlanetApp.EXPECT().GetFilmsByPlanetID(gomock.Any(), int64(1), int64(1), int64(10)).Return([]*filmModel.Film{
+					{ID: 1, Name: "A New Hope"},
+				}, nil)
+				var total int64 = 1
+				mockPlanetApp.EXPECT().GetTotalFilmsByPlanetID(gomock.Any(), int64(1)).Return(&total, nil)
+			},
+			ExpectedStatusCode: http.StatusOK,
+		},
+		"should throw error with page zero": {
+			InputParamID: "1",
+			InputQuery:   "?page=0",
+			ExpectedErr:  nil,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should throw error with limit zero": {
+			InputParamID: "1",
+			InputQuery:   "?limit=0",
+			ExpectedErr:  nil,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should throw error with parse int": {
+			InputParamID: "xpto",
+			ExpectedErr:  nil,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should throw error with parse int page": {
+			InputParamID: "1",
+			InputQuery:   "?page=xpto",
+			ExpectedErr:  nil,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should return not found when the parent does not exist": {
+			InputParamID: "1",
+			ExpectedErr:  nil,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+				mockPlanetApp.EXPECT().GetFilmsByPlanetID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, planetModel.ErrorPlanetNotFound)
+			},
+			ExpectedStatusCode: http.StatusNotFound,
+		},
+		"should return not found without links": {
+			InputParamID: "1",
+			ExpectedErr:  nil,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+				mockPlanetApp.EXPECT().GetFilmsByPlanetID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, filmModel.ErrorFilmNotFound)
+			},
+			ExpectedStatusCode: http.StatusNotFound,
+		},
+		"should throw error when get total": {
+			InputParamID: "1",
+			ExpectedErr:  nil,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+				mockPlanetApp.EXPECT().GetFilmsByPlanetID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*filmModel.Film{
+					{ID: 1, Name: "A New Hope"},
+				}, nil)
+				mockPlanetApp.EXPECT().GetTotalFilmsByPlanetID(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			ExpectedStatusCode: http.StatusInternalServerError,
+		},
+		"should throw error": {
+			InputParamID: "1",
+			ExpectedErr:  nil,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+				mockPlanetApp.EXPECT().GetFilmsByPlanetID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			ExpectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			mockPlanetApp := mockAppPlanet.NewMockApp(ctrl)
+			cs.PrepareMockApp(mockPlanetApp)
+
+			h := apiImpl{
+				apps: &app.Container{
+					Planet: mockPlanetApp,
+				},
+			}
+
+			app := fiber.New()
+			app.Get(endpoint, h.planetFilms)
+			req := httptest.NewRequest(http.MethodGet, strings.ReplaceAll(endpoint, ":planetId", cs.InputParamID)+cs.InputQuery, nil).WithContext(ctx)
+			req.Header.Set("Content-Type", fiber.MIMEApplicationJSON)
+			resp, err := app.Test(req, -1)
+			if err != nil {
+				t.Errorf("Error app.Test: %s", err.Error())
+				return
+			}
+
+			assert.Equal(t, cs.ExpectedErr, err)
+			assert.Equal(t, cs.ExpectedStatusCode, resp.StatusCode)
+		})
+	}
+}
+
+func TestHandlerCreatePlanet(t *testing.T) {
+	endpoint := "/planets"
+	cases := map[string]struct {
+		InputBody          string
+		ExpectedStatusCode int
+		ExpectedLocation   string
+		PrepareMockApp     func(mockPlanetApp *mockAppPlanet.MockApp)
+	}{
+		"should create a planet": {
+			InputBody: `{"name": "Ilum", "climate": "frozen", "terrain": "glaciers", "population": 1000, "film_ids": [1]}`,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+				var population int64 = 1000
+				mockPlanetApp.EXPECT().CreatePlanet(gomock.Any(), planetModel.PlanetRequest{
+					Name:       "Ilum",
+					Climate:    "frozen",
+					Terrain:    "glaciers",
+					Population: &population,
+					FilmIDs:    []int64{1},
+				}).Return(&planetModel.PlanetDB{
+					ID:   61,
+					Name: "Ilum",
+				}, nil)
+			},
+			ExpectedStatusCode: http.StatusCreated,
+			ExpectedLocation:   "/planets/61",
+		},
+		"should throw error with an invalid body": {
+			InputBody: `{"name": `,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should throw error with an invalid field": {
+			InputBody: `{"name": "Ilum", "climate": "frozen"}`,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should return conflict when the name already exists": {
+			InputBody: `{"name": "Tatooine", "climate": "arid", "terrain": "desert"}`,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+				mockPlanetApp.EXPECT().CreatePlanet(gomock.Any(), gomock.Any()).Return(nil, planetModel.ErrorPlanetAlreadyExists)
+			},
+			ExpectedStatusCode: http.StatusConflict,
+		},
+		"should throw error when a film does not exist": {
+			InputBody: `{"name": "Ilum", "climate": "frozen", "terrain": "glaciers", "film_ids": [99]}`,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+				mockPlanetApp.EXPECT().CreatePlanet(gomock.Any(), gomock.Any()).Return(nil, filmModel.ErrorFilmNotFound)
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should throw error": {
+			InputBody: `{"name": "Ilum", "climate": "frozen", "terrain": "glaciers"}`,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+				mockPlanetApp.EXPECT().CreatePlanet(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			ExpectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			mockPlanetApp := mockAppPlanet.NewMockApp(ctrl)
+			cs.PrepareMockApp(mockPlanetApp)
+
+			h := apiImpl{
+				apps: &app.Container{
+					Planet: mockPlanetApp,
+				},
+			}
+
+			app := fiber.New()
+			app.Post(endpoint, h.planetCreate)
+			req := httptest.NewRequest(http.MethodPost, endpoint, strings.NewReader(cs.InputBody)).WithContext(ctx)
+			req.Header.Set("Content-Type", fiber.MIMEApplicationJSON)
+			resp, err := app.Test(req, -1)
+			if err != nil {
+				t.Errorf("Error app.Test: %s", err.Error())
+				return
+			}
+
+			assert.Equal(t, cs.ExpectedStatusCode, resp.StatusCode)
+			assert.Equal(t, cs.ExpectedLocation, resp.Header.Get("Location"))
+		})
+	}
+}
+
+func TestHandlerUpdatePlanet(t *testing.T) {
+	endpoint := "/planets/:planetId"
+	cases := map[string]struct {
+		InputParamID       string
+		InputBody          string
+		ExpectedStatusCode int
+		PrepareMockApp     func(mockPlanetApp *mockAppPlanet.MockApp)
+	}{
+		"should replace a planet": {
+			InputParamID: "1",
+			InputBody:    `{"name": "Tatooine", "climate": "arid", "terrain": "desert", "film_ids": [1]}`,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+				mockPlanetApp.EXPECT().UpdatePlanet(gomock.Any(), int64(1), planetModel.PlanetRequest{
+					Name:    "Tatooine",
+					Climate: "arid",
+					Terrain: "desert",
+					FilmIDs: []int64{1},
+				}).Return(&planetModel.PlanetDB{
+					ID:   1,
+					Name: "Tatooine",
+				}, nil)
+			},
+			ExpectedStatusCode: http.StatusOK,
+		},
+		"should throw error with parse int": {
+			InputParamID: "xpto",
+			InputBody:    `{"name": "Tatooine", "climate": "arid", "terrain": "desert"}`,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should throw error with an invalid field": {
+			InputParamID: "1",
+			InputBody:    `{"name": "Tatooine", "climate": "arid"}`,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should return with planet not found": {
+			InputParamID: "1",
+			InputBody:    `{"name": "Tatooine", "climate": "arid", "terrain": "desert"}`,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+				mockPlanetApp.EXPECT().UpdatePlanet(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, planetModel.ErrorPlanetNotFound)
+			},
+			ExpectedStatusCode: http.StatusNotFound,
+		},
+		"should return conflict when the name already exists": {
+			InputParamID: "1",
+			InputBody:    `{"name": "Alderaan", "climate": "arid", "terrain": "desert"}`,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+				mockPlanetApp.EXPECT().UpdatePlanet(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, planetModel.ErrorPlanetAlreadyExists)
+			},
+			ExpectedStatusCode: http.StatusConflict,
+		},
+		"should throw error": {
+			InputParamID: "1",
+			InputBody:    `{"name": "Tatooine", "climate": "arid", "terrain": "desert"}`,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+				mockPlanetApp.EXPECT().UpdatePlanet(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			ExpectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			mockPlanetApp := mockAppPlanet.NewMockApp(ctrl)
+			cs.PrepareMockApp(mockPlanetApp)
+
+			h := apiImpl{
+				apps: &app.Container{
+					Planet: mockPlanetApp,
+				},
+			}
+
+			app := fiber.New()
+			app.Put(endpoint, h.planetUpdate)
+			req := httptest.NewRequest(http.MethodPut, strings.ReplaceAll(endpoint, ":planetId", cs.InputParamID), strings.NewReader(cs.InputBody)).WithContext(ctx)
+			req.Header.Set("Content-Type", fiber.MIMEApplicationJSON)
+			resp, err := app.Test(req, -1)
+			if err != nil {
+				t.Errorf("Error app.Test: %s", err.Error())
+				return
+			}
+
+			assert.Equal(t, cs.ExpectedStatusCode, resp.StatusCode)
+		})
+	}
+}
+
+func TestHandlerPatchPlanet(t *testing.T) {
+	endpoint := "/planets/:planetId"
+	cases := map[string]struct {
+		InputParamID       string
+		InputBody          string
+		ExpectedStatusCode int
+		PrepareMockApp     func(mockPlanetApp *mockAppPlanet.MockApp)
+	}{
+		"should update a planet": {
+			InputParamID: "1",
+			InputBody:    `{"climate": "temperate", "population": null}`,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+				mockPlanetApp.EXPECT().PatchPlanet(gomock.Any(), int64(1), planetModel.PlanetPatch{
+					"climate":    []byte(`"temperate"`),
+					"population": []byte(`null`),
+				}).Return(&planetModel.PlanetDB{
+					ID:      1,
+					Name:    "Tatooine",
+					Climate: "temperate",
+				}, nil)
+			},
+			ExpectedStatusCode: http.StatusOK,
+		},
+		"should throw error with parse int": {
+			InputParamID: "xpto",
+			InputBody:    `{"climate": "temperate"}`,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should throw error with a body that is not an object": {
+			InputParamID: "1",
+			InputBody:    `["climate"]`,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should throw error with an invalid field": {
+			InputParamID: "1",
+			InputBody:    `{"name": null}`,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+				mockPlanetApp.EXPECT().PatchPlanet(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, &planetModel.InvalidFieldError{Field: "name"})
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should return with planet not found": {
+			InputParamID: "1",
+			InputBody:    `{"climate": "temperate"}`,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+				mockPlanetApp.EXPECT().PatchPlanet(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, planetModel.ErrorPlanetNotFound)
+			},
+			ExpectedStatusCode: http.StatusNotFound,
+		},
+		"should throw error when a film does not exist": {
+			InputParamID: "1",
+			InputBody:    `{"film_ids": [99]}`,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+				mockPlanetApp.EXPECT().PatchPlanet(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, filmModel.ErrorFilmNotFound)
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should throw error": {
+			InputParamID: "1",
+			InputBody:    `{"climate": "temperate"}`,
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+				mockPlanetApp.EXPECT().PatchPlanet(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			ExpectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			mockPlanetApp := mockAppPlanet.NewMockApp(ctrl)
+			cs.PrepareMockApp(mockPlanetApp)
+
+			h := apiImpl{
+				apps: &app.Container{
+					Planet: mockPlanetApp,
+				},
+			}
+
+			app := fiber.New()
+			app.Patch(endpoint, h.planetPatch)
+			req := httptest.NewRequest(http.MethodPatch, strings.ReplaceAll(endpoint, ":planetId", cs.InputParamID), strings.NewReader(cs.InputBody)).WithContext(ctx)
+			req.Header.Set("Content-Type", "application/merge-patch+json")
+			resp, err := app.Test(req, -1)
+			if err != nil {
+				t.Errorf("Error app.Test: %s", err.Error())
+				return
+			}
+
+			assert.Equal(t, cs.ExpectedStatusCode, resp.StatusCode)
+		})
+	}
+}
+
+func TestHandlerGetDeletedPlanets(t *testing.T) {
+	cases := map[string]struct {
+		InputQuery         string
+		ExpectedStatusCode int
+		PrepareMockApp     func(mockPlanetApp *mockAppPlanet.MockApp)
+	}{
+		"should return success with deleted planets": {
+			InputQuery: "?page=1&limit=10",
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+				mockPlanetApp.EXPECT().GetDeletedPlanets(gomock.Any(), int64(1), int64(10)).Return([]*planetModel.PlanetDB{
+					{
+						ID:   1,
+						Name: "Alderaan",
+					},
+				}, nil)
+				var total int64 = 1
+				mockPlanetApp.EXPECT().GetTotalDeletedPlanets(gomock.Any()).Return(&total, nil)
+			},
+			ExpectedStatusCode: http.StatusOK,
+		},
+		"should throw error with parse int page": {
+			InputQuery: "?page=xpto",
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should throw error with page zero": {
+			InputQuery: "?page=0",
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should throw error with limit zero": {
+			InputQuery: "?limit=0",
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should return with planets not found": {
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+				mockPlanetApp.EXPECT().GetDeletedPlanets(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, planetModel.ErrorPlanetNotFound)
+			},
+			ExpectedStatusCode: http.StatusNotFound,
+		},
+		"should throw error when get total deleted planets": {
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+				mockPlanetApp.EXPECT().GetDeletedPlanets(gomock.Any(), gomock.Any(), gomock.Any()).Return([]*planetModel.PlanetDB{
+					{
+						ID:   1,
+						Name: "Alderaan",
+					},
+				}, nil)
+				mockPlanetApp.EXPECT().GetTotalDeletedPlanets(gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			ExpectedStatusCode: http.StatusInternalServerError,
+		},
+		"should throw error": {
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+				mockPlanetApp.EXPECT().GetDeletedPlanets(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			ExpectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			mockPlanetApp := mockAppPlanet.NewMockApp(ctrl)
+			cs.PrepareMockApp(mockPlanetApp)
+
+			// the routes of NewAPI, to make sure /deleted is not taken by /:planetId
+			fiberApp := fiber.New()
+			NewAPI(fiberApp.Group("/planets"), &app.Container{
+				Planet: mockPlanetApp,
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/planets/deleted"+cs.InputQuery, nil).WithContext(ctx)
+			req.Header.Set("Content-Type", fiber.MIMEApplicationJSON)
+			resp, err := fiberApp.Test(req, -1)
+			if err != nil {
+				t.Errorf("Error app.Test: %s", err.Error())
+				return
+			}
+
+			assert.Equal(t, cs.ExpectedStatusCode, resp.StatusCode)
+		})
+	}
+}
+
+func TestHandlerRestorePlanet(t *testing.T) {
+	endpoint := "/planets/:planetId/restore"
+	cases := map[string]struct {
+		InputParamID       string
+		ExpectedStatusCode int
+		PrepareMockApp     func(mockPlanetApp *mockAppPlanet.MockApp)
+	}{
+		"should restore a planet": {
+			InputParamID: "1",
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+				mockPlanetApp.EXPECT().Restore(gomock.Any(), int64(1)).Return(&planetModel.PlanetDB{
+					ID:   1,
+					Name: "Alderaan",
+				}, nil)
+			},
+			ExpectedStatusCode: http.StatusOK,
+		},
+		"should throw error with parse int": {
+			InputParamID: "xpto",
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should return with planet not found": {
+			InputParamID: "1",
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+				mockPlanetApp.EXPECT().Restore(gomock.Any(), gomock.Any()).Return(nil, planetModel.ErrorPlanetNotFound)
+			},
+			ExpectedStatusCode: http.StatusNotFound,
+		},
+		"should return conflict when the name was taken": {
+			InputParamID: "1",
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+				mockPlanetApp.EXPECT().Restore(gomock.Any(), gomock.Any()).Return(nil, planetModel.ErrorPlanetAlreadyExists)
+			},
+			ExpectedStatusCode: http.StatusConflict,
+		},
+		"should throw error": {
+			InputParamID: "1",
+			PrepareMockApp: func(mockPlanetApp *mockAppPlanet.MockApp) {
+				mockPlanetApp.EXPECT().Restore(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			ExpectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			mockPlanetApp := mockAppPlanet.NewMockApp(ctrl)
+			cs.PrepareMockApp(mockPlanetApp)
+
+			h := apiImpl{
+				apps: &app.Container{
+					Planet: mockPlanetApp,
+				},
+			}
+
+			app := fiber.New()
+			app.Post(endpoint, h.planetRestore)
+			req := httptest.NewRequest(http.MethodPost, strings.ReplaceAll(endpoint, ":planetId", cs.InputParamID), nil).WithContext(ctx)
+			req.Header.Set("Content-Type", fiber.MIMEApplicationJSON)
+			resp, err := app.Test(req, -1)
+			if err != nil {
+				t.Errorf("Error app.Test: %s", err.Error())
+				return
+			}
+
+			assert.Equal(t, cs.ExpectedStatusCode, resp.StatusCode)
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package resource
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	resourceApp "github.com/danilotadeu/star_wars/app/resource"
+	errorsP "github.com/danilotadeu/star_wars/model/errors_handler"
+	genericModel "github.com/danilotadeu/star_wars/model/generic"
+	resourceModel "github.com/danilotadeu/star_wars/model/resource"
+	"github.com/gofiber/fiber/v2"
+	"github.com/sirupsen/logrus"
+)
+
+// Handler serve a resource listed with its films, like starships..
+type Handler struct {
+	kind            string
+	app             resourceApp.App
+	notFound        error
+	notFoundMessage string
+}
+
+// NewHandler init the handler of the resource kind, notFoundMessage is formatted with the id asked when app return notFound..
+func NewHandler(kind string, app resourceApp.App, notFound error, notFoundMessage string) *Handler {
+	return &Handler{
+		kind:            kind,
+		app:             app,
+		notFound:        notFound,
+		notFoundMessage: notFoundMessage,
+	}
+}
+
+func (h *Handler) trace(trace string) *logrus.Entry {
+	return logrus.WithFields(logrus.Fields{"trace": "api.resource." + trace, "resource": h.kind})
+}
+
+// One return the resource of the id param..
+func (h *Handler) One(c *fiber.Ctx) error {
+	id, err := strconv.ParseInt(c.Params("id"), 10, 64)
+	if err != nil {
+		h.trace("One.ParseInt").Error(err)
+		return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+			Message: "Por favor envie o id",
+		})
+	}
+
+	ctx := c.Context()
+	record, err := h.app.GetOneByID(ctx, id)
+	if err != nil {
+		h.trace("One.GetOneByID").Error(err)
+		if errors.Is(err, h.notFound) {
+			return c.Status(http.StatusNotFound).JSON(errorsP.ErrorsResponse{
+				Message: fmt.Sprintf(h.notFoundMessage, id),
+			})
+		}
+		return c.Status(http.StatusInternalServerError).JSON(errorsP.ErrorsResponse{
+			Message: "Aconteceu um erro interno..",
+		})
+	}
+
+	return c.Status(http.StatusOK).JSON(record)
+}
+
+// List return a page of the resources filtered by the page, limit and name queries..
+func (h *Handler) List(c *fiber.Ctx) error {
+	ctx := c.Context()
+
+	ilimit, err := genericModel.ParseLimit(c.Query("limit"))
+	if err != nil {
+		h.trace("List.ParseLimit").Error(err)
+		return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+			Message: "Por favor envie o limit corretamente.",
+		})
+	}
+
+	ipage, err := genericModel.ParsePage(c.Query("page"))
+	if err != nil {
+		h.trace("List.ParsePage").Error(err)
+		return c.Status(http.StatusBadRequest).JSON(errorsP.ErrorsResponse{
+			Message: "Por favor envie o page corretamente.",
+		})
+	}
+
+	name := c.Query("name")
+
+	records, err := h.app.GetAll(ctx, ipage, ilimit, name)
+	if err != nil {
+		h.trace("List.GetAll").Error(err)
+		if errors.Is(err, h.notFound) {
+			return c.Status(http.StatusNotFound).JSON(errorsP.ErrorsResponse{
+				Message: "Dados nao encontrados",
+			})
+		}
+
+		return c.Status(http.StatusInternalServerError).JSON(errorsP.ErrorsResponse{
+			Message: "Aconteceu um erro interno..",
+		})
+	}
+
+	total, err := h.app.GetTotal(ctx, name)
+	if err != nil {
+		h.trace("List.GetTotal").Error(err)
+		return c.Status(http.StatusInternalServerError).JSON(errorsP.ErrorsResponse{
+			Message: "Aconteceu um erro interno..",
+		})
+	}
+
+	return c.Status(http.StatusOK).JSON(resourceModel.Response{
+		Data:               records,
+		ResponsePagination: genericModel.NewPagination(ipage, ilimit, *total),
+	})
+}
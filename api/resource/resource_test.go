@@ -0,0 +1,199 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	mockAppResource "github.com/danilotadeu/star_wars/mock/app/resource"
+	resourceModel "github.com/danilotadeu/star_wars/model/resource"
+	starshipModel "github.com/danilotadeu/star_wars/model/starship"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestHandlerOne(t *testing.T) {
+	endpoint := "/starships/:id"
+	cases := map[string]struct {
+		InputParamID       string
+		ExpectedErr        error
+		ExpectedStatusCode int
+		PrepareMockApp     func(mockResourceApp *mockAppResource.MockApp)
+	}{
+		"should return success with resource": {
+			InputParamID: "1",
+			ExpectedErr:  nil,
+			PrepareMockApp: func(mockResourceApp *mockAppResource.MockApp) {
+				mockResourceApp.EXPECT().GetOneByID(gomock.Any(), int64(1)).Return(&starshipModel.StarshipDB{
+					ID:   1,
+					Name: "X-wing",
+				}, nil)
+			},
+			ExpectedStatusCode: http.StatusOK,
+		},
+		"should throw error with parse int": {
+			InputParamID: "xpto",
+			ExpectedErr:  nil,
+			PrepareMockApp: func(mockResourceApp *mockAppResource.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should return with resource not found": {
+			InputParamID: "1",
+			ExpectedErr:  nil,
+			PrepareMockApp: func(mockResourceApp *mockAppResource.MockApp) {
+				mockResourceApp.EXPECT().GetOneByID(gomock.Any(), gomock.Any()).Return(nil, starshipModel.ErrorStarshipNotFound)
+			},
+			ExpectedStatusCode: http.StatusNotFound,
+		},
+		"should throw error": {
+			InputParamID: "1",
+			ExpectedErr:  nil,
+			PrepareMockApp: func(mockResourceApp *mockAppResource.MockApp) {
+				mockResourceApp.EXPECT().GetOneByID(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			ExpectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			mockResourceApp := mockAppResource.NewMockApp(ctrl)
+			cs.PrepareMockApp(mockResourceApp)
+
+			h := NewHandler("starship", mockResourceApp, starshipModel.ErrorStarshipNotFound, "Nave (%d) não encontrada")
+
+			app := fiber.New()
+			app.Get(endpoint, h.One)
+			req := httptest.NewRequest(http.MethodGet, strings.ReplaceAll(endpoint, ":id", cs.InputParamID), nil).WithContext(ctx)
+			req.Header.Set("Content-Type", fiber.MIMEApplicationJSON)
+			resp, err := app.Test(req, -1)
+			if err != nil {
+				t.Errorf("Error app.Test: %s", err.Error())
+				return
+			}
+
+			assert.Equal(t, cs.ExpectedErr, err)
+			assert.Equal(t, cs.ExpectedStatusCode, resp.StatusCode)
+		})
+	}
+}
+
+func TestHandlerList(t *testing.T) {
+	cases := map[string]struct {
+		InputPage          string
+		InputLimit         string
+		ExpectedErr        error
+		ExpectedStatusCode int
+		PrepareMockApp     func(mockResourceApp *mockAppResource.MockApp)
+	}{
+		"should return success with resources": {
+			InputPage:   "1",
+			InputLimit:  "10",
+			ExpectedErr: nil,
+			PrepareMockApp: func(mockResourceApp *mockAppResource.MockApp) {
+				mockResourceApp.EXPECT().GetAll(gomock.Any(), int64(1), int64(10), "").Return([]resourceModel.Record{
+					&starshipModel.StarshipDB{
+						ID:   1,
+						Name: "X-wing",
+					},
+				}, nil)
+				var total int64 = 1
+				mockResourceApp.EXPECT().GetTotal(gomock.Any(), gomock.Any()).Return(&total, nil)
+			},
+			ExpectedStatusCode: http.StatusOK,
+		},
+		"should throw error when get total": {
+			InputPage:   "1",
+			InputLimit:  "10",
+			ExpectedErr: nil,
+			PrepareMockApp: func(mockResourceApp *mockAppResource.MockApp) {
+				mockResourceApp.EXPECT().GetAll(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]resourceModel.Record{
+					&starshipModel.StarshipDB{
+						ID:   1,
+						Name: "X-wing",
+					},
+				}, nil)
+				mockResourceApp.EXPECT().GetTotal(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			ExpectedStatusCode: http.StatusInternalServerError,
+		},
+		"should throw error with page zero": {
+			ExpectedErr: nil,
+			InputPage:   "0",
+			PrepareMockApp: func(mockResourceApp *mockAppResource.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should throw error with limit zero": {
+			ExpectedErr: nil,
+			InputLimit:  "0",
+			PrepareMockApp: func(mockResourceApp *mockAppResource.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should throw error with parse int page": {
+			ExpectedErr: nil,
+			InputPage:   "xpto",
+			PrepareMockApp: func(mockResourceApp *mockAppResource.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should throw error with parse int limit": {
+			ExpectedErr: nil,
+			InputLimit:  "xpto",
+			PrepareMockApp: func(mockResourceApp *mockAppResource.MockApp) {
+			},
+			ExpectedStatusCode: http.StatusBadRequest,
+		},
+		"should return with resources not found": {
+			ExpectedErr: nil,
+			PrepareMockApp: func(mockResourceApp *mockAppResource.MockApp) {
+				mockResourceApp.EXPECT().GetAll(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, starshipModel.ErrorStarshipNotFound)
+			},
+			ExpectedStatusCode: http.StatusNotFound,
+		},
+		"should throw error": {
+			ExpectedErr: nil,
+			PrepareMockApp: func(mockResourceApp *mockAppResource.MockApp) {
+				mockResourceApp.EXPECT().GetAll(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			ExpectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			mockResourceApp := mockAppResource.NewMockApp(ctrl)
+			cs.PrepareMockApp(mockResourceApp)
+
+			h := NewHandler("starship", mockResourceApp, starshipModel.ErrorStarshipNotFound, "Nave (%d) não encontrada")
+			endpoint := "/starships"
+			app := fiber.New()
+			app.Get(endpoint, h.List)
+
+			if len(cs.InputPage) > 0 && len(cs.InputLimit) > 0 {
+				endpoint += "?page=" + cs.InputPage + "&limit=" + cs.InputLimit
+			} else if len(cs.InputPage) > 0 {
+				endpoint += "?page=" + cs.InputPage
+			} else if len(cs.InputLimit) > 0 {
+				endpoint += "?limit=" + cs.InputLimit
+			}
+
+			req := httptest.NewRequest(http.MethodGet, endpoint, nil).WithContext(ctx)
+			req.Header.Set("Content-Type", fiber.MIMEApplicationJSON)
+			resp, err := app.Test(req, -1)
+			if err != nil {
+				t.Errorf("Error app.Test: %s", err.Error())
+				return
+			}
+
+			assert.Equal(t, cs.ExpectedErr, err)
+			assert.Equal(t, cs.ExpectedStatusCode, resp.StatusCode)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package species
+
+import (
+	"github.com/danilotadeu/star_wars/api/resource"
+	"github.com/danilotadeu/star_wars/app"
+	speciesModel "github.com/danilotadeu/star_wars/model/species"
+	"github.com/gofiber/fiber/v2"
+)
+
+type apiImpl struct {
+	handler *resource.Handler
+}
+
+// NewAPI species function..
+func NewAPI(g fiber.Router, apps *app.Container) {
+	api := apiImpl{
+		handler: resource.NewHandler("species", apps.Species, speciesModel.ErrorSpeciesNotFound, "Espécie (%d) não encontrada"),
+	}
+
+	g.Get("/", api.speciesList)
+	g.Get("/:id", api.species)
+}
+
+// ShowSpecies godoc
+// @Summary      Show a species
+// @Description  get species by ID
+// @Tags         species
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Species ID"
+// @Success      200  {object}  speciesModel.SpeciesDB
+// @Failure      400  {object}  errors_handler.ErrorsResponse
+// @Failure      404  {object}  errors_handler.ErrorsResponse
+// @Failure      500  {object}  errors_handler.ErrorsResponse
+// @Router       /species/{id} [get]
+func (p *apiImpl) species(c *fiber.Ctx) error {
+	return p.handler.One(c)
+}
+
+// ListSpecies godoc
+// @Summary      List species
+// @Description  get species
+// @Tags         species
+// @Accept       json
+// @Produce      json
+// @Param page query int false "page, starting at 1"
+// @Param limit query int false "limit, at most 100"
+// @Param name query string false "name"
+// @Success      200  {object}  speciesModel.ResponseSpecies
+// @Failure      400  {object}  errors_handler.ErrorsResponse
+// @Failure      404  {object}  errors_handler.ErrorsResponse
+// @Failure      500  {object}  errors_handler.ErrorsResponse
+// @Router       /species [get]
+func (p *apiImpl) speciesList(c *fiber.Ctx) error {
+	return p.handler.List(c)
+}
@@ -0,0 +1,56 @@
+package starship
+
+import (
+	"github.com/danilotadeu/star_wars/api/resource"
+	"github.com/danilotadeu/star_wars/app"
+	starshipModel "github.com/danilotadeu/star_wars/model/starship"
+	"github.com/gofiber/fiber/v2"
+)
+
+type apiImpl struct {
+	handler *resource.Handler
+}
+
+// NewAPI starship function..
+func NewAPI(g fiber.Router, apps *app.Container) {
+	api := apiImpl{
+		handler: resource.NewHandler("starship", apps.Starship, starshipModel.ErrorStarshipNotFound, "Nave (%d) não encontrada"),
+	}
+
+	g.Get("/", api.starships)
+	g.Get("/:id", api.starship)
+}
+
+// ShowStarship godoc
+// @Summary      Show a starship
+// @Description  get starship by ID
+// @Tags         starships
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Starship ID"
+// @Success      200  {object}  starshipModel.StarshipDB
+// @Failure      400  {object}  errors_handler.ErrorsResponse
+// @Failure      404  {object}  errors_handler.ErrorsResponse
+// @Failure      500  {object}  errors_handler.ErrorsResponse
+// @Router       /starships/{id} [get]
+func (p *apiImpl) starship(c *fiber.Ctx) error {
+	return p.handler.One(c)
+}
+
+// ListStarships godoc
+// @Summary      List starships
+// @Description  get starships
+// @Tags         starships
+// @Accept       json
+// @Produce      json
+// @Param page query int false "page, starting at 1"
+// @Param limit query int false "limit, at most 100"
+// @Param name query string false "name"
+// @Success      200  {object}  starshipModel.ResponseStarships
+// @Failure      400  {object}  errors_handler.ErrorsResponse
+// @Failure      404  {object}  errors_handler.ErrorsResponse
+// @Failure      500  {object}  errors_handler.ErrorsResponse
+// @Router       /starships [get]
+func (p *apiImpl) starships(c *fiber.Ctx) error {
+	return p.handler.List(c)
+}
@@ -0,0 +1,56 @@
+package vehicle
+
+import (
+	"github.com/danilotadeu/star_wars/api/resource"
+	"github.com/danilotadeu/star_wars/app"
+	vehicleModel "github.com/danilotadeu/star_wars/model/vehicle"
+	"github.com/gofiber/fiber/v2"
+)
+
+type apiImpl struct {
+	handler *resource.Handler
+}
+
+// NewAPI vehicle function..
+func NewAPI(g fiber.Router, apps *app.Container) {
+	api := apiImpl{
+		handler: resource.NewHandler("vehicle", apps.Vehicle, vehicleModel.ErrorVehicleNotFound, "Veículo (%d) não encontrado"),
+	}
+
+	g.Get("/", api.vehicles)
+	g.Get("/:id", api.vehicle)
+}
+
+// ShowVehicle godoc
+// @Summary      Show a vehicle
+// @Description  get vehicle by ID
+// @Tags         vehicles
+// @Accept       json
+// @Produce      json
+// @Param        id   path      int  true  "Vehicle ID"
+// @Success      200  {object}  vehicleModel.VehicleDB
+// @Failure      400  {object}  errors_handler.ErrorsResponse
+// @Failure      404  {object}  errors_handler.ErrorsResponse
+// @Failure      500  {object}  errors_handler.ErrorsResponse
+// @Router       /vehicles/{id} [get]
+func (p *apiImpl) vehicle(c *fiber.Ctx) error {
+	return p.handler.One(c)
+}
+
+// ListVehicles godoc
+// @Summary      List vehicles
+// @Description  get vehicles
+// @Tags         vehicles
+// @Accept       json
+// @Produce      json
+// @Param page query int false "page, starting at 1"
+// @Param limit query int false "limit, at most 100"
+// @Param name query string false "name"
+// @Success      200  {object}  vehicleModel.ResponseVehicles
+// @Failure      400  {object}  errors_handler.ErrorsResponse
+// @Failure      404  {object}  errors_handler.ErrorsResponse
+// @Failure      500  {object}  errors_handler.ErrorsResponse
+// @Router       /vehicles [get]
+func (p *apiImpl) vehicles(c *fiber.Ctx) error {
+	return p.handler.List(c)
+}
@@ -1,20 +1,36 @@
 package app
 
 import (
+	"github.com/danilotadeu/star_wars/app/film"
+	"github.com/danilotadeu/star_wars/app/people"
 	"github.com/danilotadeu/star_wars/app/planet"
+	"github.com/danilotadeu/star_wars/app/resource"
+	speciesModel "github.com/danilotadeu/star_wars/model/species"
+	starshipModel "github.com/danilotadeu/star_wars/model/starship"
+	vehicleModel "github.com/danilotadeu/star_wars/model/vehicle"
 	"github.com/danilotadeu/star_wars/store"
 	"github.com/sirupsen/logrus"
 )
 
 // Container ...
 type Container struct {
-	Planet planet.App
+	Planet   planet.App
+	Film     film.App
+	People   people.App
+	Starship resource.App
+	Vehicle  resource.App
+	Species  resource.App
 }
 
 // Register app container
 func Register(store *store.Container) *Container {
 	container := &Container{
-		Planet: planet.NewApp(store),
+		Planet:   planet.NewApp(store),
+		Film:     film.NewApp(store),
+		People:   people.NewApp(store),
+		Starship: resource.NewApp[*starshipModel.StarshipDB]("starship", store.Starship, starshipModel.ErrorStarshipNotFound),
+		Vehicle:  resource.NewApp[*vehicleModel.VehicleDB]("vehicle", store.Vehicle, vehicleModel.ErrorVehicleNotFound),
+		Species:  resource.NewApp[*speciesModel.SpeciesDB]("species", store.Species, speciesModel.ErrorSpeciesNotFound),
 	}
 
 	logrus.WithFields(logrus.Fields{"trace": "app"}).Infof("Registered - App")
@@ -0,0 +1,127 @@
+package film
+
+import (
+	"context"
+
+	filmModel "github.com/danilotadeu/star_wars/model/film"
+	genericModel "github.com/danilotadeu/star_wars/model/generic"
+	planetModel "github.com/danilotadeu/star_wars/model/planet"
+	"github.com/danilotadeu/star_wars/store"
+	"github.com/sirupsen/logrus"
+)
+
+//go:generate mockgen -destination ../../mock/app/film/film_app_mock.go -package mockAppFilm . App
+type App interface {
+	GetOneByID(ctx context.Context, filmID int64) (*filmModel.Film, error)
+	GetAllFilms(ctx context.Context, page, limit int64, filter filmModel.Filter) ([]*filmModel.Film, error)
+	GetFilmsByCursor(ctx context.Context, cursor *genericModel.Cursor, limit int64, filter filmModel.Filter) ([]*filmModel.Film, *genericModel.CursorPagination, error)
+	GetTotalFilms(ctx context.Context, filter filmModel.Filter) (*int64, error)
+	GetPlanetsByFilmID(ctx context.Context, filmID, page, limit int64) ([]*planetModel.PlanetDB, error)
+	GetTotalPlanetsByFilmID(ctx context.Context, filmID int64) (*int64, error)
+}
+
+type appImpl struct {
+	store *store.Container
+}
+
+// NewApp init a film
+func NewApp(store *store.Container) App {
+	return &appImpl{
+		store: store,
+	}
+}
+
+func (a *appImpl) GetOneByID(ctx context.Context, filmID int64) (*filmModel.Film, error) {
+	film, err := a.store.Film.GetOneByID(ctx, filmID)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.film.GetOneByID.Store.Film.GetOneByID"}).Error(err)
+		return nil, err
+	}
+
+	return film, nil
+}
+
+// GetAllFilms list a page, starting at 1, of the films matching filter..
+func (a *appImpl) GetAllFilms(ctx context.Context, page, limit int64, filter filmModel.Filter) ([]*filmModel.Film, error) {
+	films, err := a.store.Film.GetAll(ctx, genericModel.Offset(page, limit), limit, filter)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.film.GetAllFilms.Store.Film.GetAll"}).Error(err)
+		return nil, err
+	}
+
+	if len(films) == 0 {
+		return nil, filmModel.ErrorFilmNotFound
+	}
+
+	return films, nil
+}
+
+// GetFilmsByCursor list up to limit films matching filter after the cursor, or before it when the cursor
+// is backward, nil being the first page. The pagination carries the cursors of the pages around it..
+func (a *appImpl) GetFilmsByCursor(ctx context.Context, cursor *genericModel.Cursor, limit int64, filter filmModel.Filter) ([]*filmModel.Film, *genericModel.CursorPagination, error) {
+	if cursor != nil && cursor.Sort != filmModel.CursorSort {
+		return nil, nil, genericModel.ErrorInvalidCursor
+	}
+
+	// one film more than the page tells if there is another page past it
+	films, err := a.store.Film.GetAllByCursor(ctx, cursor, limit+1, filter)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.film.GetFilmsByCursor.Store.Film.GetAllByCursor"}).Error(err)
+		return nil, nil, err
+	}
+
+	more := int64(len(films)) > limit
+	if more {
+		if cursor != nil && cursor.Backward {
+			films = films[1:]
+		} else {
+			films = films[:limit]
+		}
+	}
+
+	if len(films) == 0 {
+		return nil, nil, filmModel.ErrorFilmNotFound
+	}
+
+	pagination := genericModel.NewCursorPagination(cursor, limit, more, films[0].Cursor(), films[len(films)-1].Cursor())
+	return films, &pagination, nil
+}
+
+func (a *appImpl) GetTotalFilms(ctx context.Context, filter filmModel.Filter) (*int64, error) {
+	total, err := a.store.Film.GetTotalFilms(ctx, filter)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.film.GetTotalFilms.Store.Film.GetTotalFilms"}).Error(err)
+		return nil, err
+	}
+	return total, nil
+}
+
+// GetPlanetsByFilmID list the planets of a film, ErrorFilmNotFound when the film does not exist..
+func (a *appImpl) GetPlanetsByFilmID(ctx context.Context, filmID, page, limit int64) ([]*planetModel.PlanetDB, error) {
+	film, err := a.store.Film.GetOneByID(ctx, filmID)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.film.GetPlanetsByFilmID.Store.Film.GetOneByID"}).Error(err)
+		return nil, err
+	}
+
+	planets, err := a.store.Planet.GetAllByFilmID(ctx, film.ID, genericModel.Offset(page, limit), limit)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.film.GetPlanetsByFilmID.Store.Planet.GetAllByFilmID"}).Error(err)
+		return nil, err
+	}
+
+	if len(planets) == 0 {
+		return nil, planetModel.ErrorPlanetNotFound
+	}
+
+	return planets, nil
+}
+
+func (a *appImpl) GetTotalPlanetsByFilmID(ctx context.Context, filmID int64) (*int64, error) {
+	total, err := a.store.Planet.GetTotalPlanetsByFilmID(ctx, filmID)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.film.GetTotalPlanetsByFilmID.Store.Planet.GetTotalPlanetsByFilmID"}).Error(err)
+		return nil, err
+	}
+	return total, nil
+}
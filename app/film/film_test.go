@@ -0,0 +1,413 @@
+package film
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	mockStoreFilm "github.com/danilotadeu/star_wars/mock/store/film"
+	mockStorePlanet "github.com/danilotadeu/star_wars/mock/store/planet"
+	filmModel "github.com/danilotadeu/star_wars/model/film"
+	genericModel "github.com/danilotadeu/star_wars/model/generic"
+	planetModel "github.com/danilotadeu/star_wars/model/planet"
+	"github.com/danilotadeu/star_wars/store"
+	"github.com/golang/mock/gomock"
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestGetAllFilms(t *testing.T) {
+	dateString := "2021-11-22"
+	date, _ := time.Parse("2006-01-02", dateString)
+	var episodeID int64 = 4
+	filmsExpected := []*filmModel.Film{
+		{
+			ID:          1,
+			Name:        "A New Hope",
+			EpisodeID:   &episodeID,
+			Director:    "George Lucas",
+			ReleaseDate: date,
+			CreatedAt:   date,
+		},
+	}
+	cases := map[string]struct {
+		inputPage     int64
+		inputLimit    int64
+		inputFilter   filmModel.Filter
+		prepareMock   func(filmStore *mockStoreFilm.MockStore)
+		expectedFilms []*filmModel.Film
+		expectedErr   error
+	}{
+		"should get all films": {
+			inputPage:   2,
+			inputLimit:  5,
+			inputFilter: filmModel.Filter{Director: "Lucas"},
+			prepareMock: func(filmStore *mockStoreFilm.MockStore) {
+				filmStore.EXPECT().GetAll(gomock.Any(), int64(5), int64(5), filmModel.Filter{Director: "Lucas"}).Return([]*filmModel.Film{
+					{
+						ID:          1,
+						Name:        "A New Hope",
+						EpisodeID:   &episodeID,
+						Director:    "George Lucas",
+						ReleaseDate: date,
+						CreatedAt:   date,
+					},
+				}, nil)
+			},
+			expectedFilms: filmsExpected,
+			expectedErr:   nil,
+		},
+		"should return empty films": {
+			inputPage:   1,
+			inputLimit:  5,
+			inputFilter: filmModel.Filter{Title: "Hope"},
+			prepareMock: func(filmStore *mockStoreFilm.MockStore) {
+				filmStore.EXPECT().GetAll(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			expectedFilms: nil,
+			expectedErr:   filmModel.ErrorFilmNotFound,
+		},
+		"should throw error when get all films": {
+			inputPage:  1,
+			inputLimit: 5,
+			prepareMock: func(filmStore *mockStoreFilm.MockStore) {
+				filmStore.EXPECT().GetAll(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			expectedFilms: nil,
+			expectedErr:   fmt.Errorf("error"),
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			// given
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			defer ctrl.Finish()
+
+			filmStoreMock := mockStoreFilm.NewMockStore(ctrl)
+
+			cs.prepareMock(filmStoreMock)
+			app := NewApp(&store.Container{
+				Film: filmStoreMock,
+			})
+
+			// when
+			films, err := app.GetAllFilms(ctx, cs.inputPage, cs.inputLimit, cs.inputFilter)
+
+			// then
+			assert.Equal(t, cs.expectedErr, err)
+			assert.Equal(t, cs.expectedFilms, films)
+		})
+	}
+}
+
+func TestGetFilmsByCursor(t *testing.T) {
+	var episodeIV int64 = 4
+	var episodeV int64 = 5
+	var episodeVI int64 = 6
+	films := []*filmModel.Film{
+		{ID: 1, Name: "A New Hope", EpisodeID: &episodeIV},
+		{ID: 2, Name: "The Empire Strikes Back", EpisodeID: &episodeV},
+		{ID: 3, Name: "Return of the Jedi", EpisodeID: &episodeVI},
+	}
+	cursor := &genericModel.Cursor{Sort: filmModel.CursorSort, Keys: []string{"0", "4"}, ID: 1}
+	backwardCursor := &genericModel.Cursor{Sort: filmModel.CursorSort, Keys: []string{"0", "6"}, ID: 3, Backward: true}
+	nextCursor := genericModel.Cursor{Sort: filmModel.CursorSort, Keys: []string{"0", "5"}, ID: 2}.Encode()
+	prevCursor := genericModel.Cursor{Sort: filmModel.CursorSort, Keys: []string{"0", "5"}, ID: 2, Backward: true}.Encode()
+	firstNextCursor := genericModel.Cursor{Sort: filmModel.CursorSort, Keys: []string{"0", "4"}, ID: 1}.Encode()
+	lastPrevCursor := genericModel.Cursor{Sort: filmModel.CursorSort, Keys: []string{"0", "6"}, ID: 3, Backward: true}.Encode()
+	cases := map[string]struct {
+		inputCursor        *genericModel.Cursor
+		prepareMock        func(filmStore *mockStoreFilm.MockStore)
+		expectedFilms      []*filmModel.Film
+		expectedPagination *genericModel.CursorPagination
+		expectedErr        error
+	}{
+		"should get the first page with the next cursor": {
+			inputCursor: nil,
+			prepareMock: func(filmStore *mockStoreFilm.MockStore) {
+				filmStore.EXPECT().GetAllByCursor(gomock.Any(), nil, int64(2), filmModel.Filter{}).Return(films[:2], nil)
+			},
+			expectedFilms:      films[:1],
+			expectedPagination: &genericModel.CursorPagination{Limit: 1, NextCursor: &firstNextCursor},
+			expectedErr:        nil,
+		},
+		"should get the page after the cursor": {
+			inputCursor: cursor,
+			prepareMock: func(filmStore *mockStoreFilm.MockStore) {
+				filmStore.EXPECT().GetAllByCursor(gomock.Any(), cursor, int64(2), filmModel.Filter{}).Return(films[1:], nil)
+			},
+			expectedFilms:      films[1:2],
+			expectedPagination: &genericModel.CursorPagination{Limit: 1, NextCursor: &nextCursor, PrevCursor: &prevCursor},
+			expectedErr:        nil,
+		},
+		"should get the page before a backward cursor": {
+			inputCursor: backwardCursor,
+			prepareMock: func(filmStore *mockStoreFilm.MockStore) {
+				filmStore.EXPECT().GetAllByCursor(gomock.Any(), backwardCursor, int64(2), filmModel.Filter{}).Return(films[:2], nil)
+			},
+			expectedFilms:      films[1:2],
+			expectedPagination: &genericModel.CursorPagination{Limit: 1, NextCursor: &nextCursor, PrevCursor: &prevCursor},
+			expectedErr:        nil,
+		},
+		"should not have next cursor in the last page": {
+			inputCursor: &genericModel.Cursor{Sort: filmModel.CursorSort, Keys: []string{"0", "5"}, ID: 2},
+			prepareMock: func(filmStore *mockStoreFilm.MockStore) {
+				filmStore.EXPECT().GetAllByCursor(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(films[2:], nil)
+			},
+			expectedFilms:      films[2:],
+			expectedPagination: &genericModel.CursorPagination{Limit: 1, PrevCursor: &lastPrevCursor},
+			expectedErr:        nil,
+		},
+		"should return film not found past the last film": {
+			inputCursor: &genericModel.Cursor{Sort: filmModel.CursorSort, Keys: []string{"0", "6"}, ID: 3},
+			prepareMock: func(filmStore *mockStoreFilm.MockStore) {
+				filmStore.EXPECT().GetAllByCursor(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			expectedFilms:      nil,
+			expectedPagination: nil,
+			expectedErr:        filmModel.ErrorFilmNotFound,
+		},
+		"should throw error with a cursor of another sort": {
+			inputCursor: &genericModel.Cursor{Sort: "name", Keys: []string{"A New Hope"}, ID: 1},
+			prepareMock: func(filmStore *mockStoreFilm.MockStore) {
+			},
+			expectedFilms:      nil,
+			expectedPagination: nil,
+			expectedErr:        genericModel.ErrorInvalidCursor,
+		},
+		"should throw error when get films by cursor": {
+			inputCursor: cursor,
+			prepareMock: func(filmStore *mockStoreFilm.MockStore) {
+				filmStore.EXPECT().GetAllByCursor(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, genericModel.ErrorInvalidCursor)
+			},
+			expectedFilms:      nil,
+			expectedPagination: nil,
+			expectedErr:        genericModel.ErrorInvalidCursor,
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			// given
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			defer ctrl.Finish()
+
+			filmStoreMock := mockStoreFilm.NewMockStore(ctrl)
+
+			cs.prepareMock(filmStoreMock)
+			app := NewApp(&store.Container{
+				Film: filmStoreMock,
+			})
+
+			// when
+			films, pagination, err := app.GetFilmsByCursor(ctx, cs.inputCursor, 1, filmModel.Filter{})
+
+			// then
+			assert.Equal(t, cs.expectedErr, err)
+			assert.Equal(t, cs.expectedFilms, films)
+			assert.Equal(t, cs.expectedPagination, pagination)
+		})
+	}
+}
+
+func TestGetOneByID(t *testing.T) {
+	dateString := "2021-11-22"
+	date, _ := time.Parse("2006-01-02", dateString)
+	filmExpected := filmModel.Film{
+		ID:          1,
+		Name:        "A New Hope",
+		Director:    "George Lucas",
+		ReleaseDate: date,
+		CreatedAt:   date,
+	}
+
+	cases := map[string]struct {
+		inputFilm    int64
+		prepareMock  func(filmStore *mockStoreFilm.MockStore)
+		expectedFilm *filmModel.Film
+		expectedErr  error
+	}{
+		"should return a film with success": {
+			inputFilm: 1,
+			prepareMock: func(filmStore *mockStoreFilm.MockStore) {
+				filmStore.EXPECT().GetOneByID(gomock.Any(), int64(1)).Return(&filmModel.Film{
+					ID:          1,
+					Name:        "A New Hope",
+					Director:    "George Lucas",
+					ReleaseDate: date,
+					CreatedAt:   date,
+				}, nil)
+			},
+			expectedFilm: &filmExpected,
+			expectedErr:  nil,
+		},
+		"should return film not found": {
+			inputFilm: 1,
+			prepareMock: func(filmStore *mockStoreFilm.MockStore) {
+				filmStore.EXPECT().GetOneByID(gomock.Any(), gomock.Any()).Return(nil, filmModel.ErrorFilmNotFound)
+			},
+			expectedFilm: nil,
+			expectedErr:  filmModel.ErrorFilmNotFound,
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			// given
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			defer ctrl.Finish()
+
+			filmStoreMock := mockStoreFilm.NewMockStore(ctrl)
+
+			cs.prepareMock(filmStoreMock)
+			app := NewApp(&store.Container{
+				Film: filmStoreMock,
+			})
+
+			// when
+			filmDB, err := app.GetOneByID(ctx, cs.inputFilm)
+
+			// then
+			assert.Equal(t, cs.expectedErr, err)
+			assert.Equal(t, filmDB, cs.expectedFilm)
+		})
+	}
+}
+
+func TestGetTotalFilms(t *testing.T) {
+	var total int64 = 6
+	cases := map[string]struct {
+		prepareMock   func(filmStore *mockStoreFilm.MockStore)
+		expectedTotal *int64
+		expectedErr   error
+	}{
+		"should return a total of films": {
+			prepareMock: func(filmStore *mockStoreFilm.MockStore) {
+				filmStore.EXPECT().GetTotalFilms(gomock.Any(), filmModel.Filter{}).Return(&total, nil)
+			},
+			expectedTotal: &total,
+			expectedErr:   nil,
+		},
+		"should throw error when get a total": {
+			prepareMock: func(filmStore *mockStoreFilm.MockStore) {
+				filmStore.EXPECT().GetTotalFilms(gomock.Any(), filmModel.Filter{}).Return(nil, fmt.Errorf("error"))
+			},
+			expectedTotal: nil,
+			expectedErr:   fmt.Errorf("error"),
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			// given
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			defer ctrl.Finish()
+
+			filmStoreMock := mockStoreFilm.NewMockStore(ctrl)
+
+			cs.prepareMock(filmStoreMock)
+			app := NewApp(&store.Container{
+				Film: filmStoreMock,
+			})
+
+			// when
+			total, err := app.GetTotalFilms(ctx, filmModel.Filter{})
+
+			// then
+			assert.Equal(t, cs.expectedErr, err)
+			assert.Equal(t, total, cs.expectedTotal)
+		})
+	}
+}
+
+func TestGetPlanetsByFilmID(t *testing.T) {
+	planetsExpected := []*planetModel.PlanetDB{
+		{
+			ID:      1,
+			Name:    "Tatooine",
+			Climate: "arid",
+			Terrain: "desert",
+		},
+	}
+
+	cases := map[string]struct {
+		inputFilm       int64
+		prepareMock     func(filmStore *mockStoreFilm.MockStore, planetStore *mockStorePlanet.MockStore)
+		expectedPlanets []*planetModel.PlanetDB
+		expectedErr     error
+	}{
+		"should return the planets of a film": {
+			inputFilm: 1,
+			prepareMock: func(filmStore *mockStoreFilm.MockStore, planetStore *mockStorePlanet.MockStore) {
+				filmStore.EXPECT().GetOneByID(gomock.Any(), int64(1)).Return(&filmModel.Film{
+					ID: 1,
+				}, nil)
+				planetStore.EXPECT().GetAllByFilmID(gomock.Any(), int64(1), int64(0), int64(10)).Return([]*planetModel.PlanetDB{
+					{
+						ID:      1,
+						Name:    "Tatooine",
+						Climate: "arid",
+						Terrain: "desert",
+					},
+				}, nil)
+			},
+			expectedPlanets: planetsExpected,
+			expectedErr:     nil,
+		},
+		"should return film not found": {
+			inputFilm: 1,
+			prepareMock: func(filmStore *mockStoreFilm.MockStore, planetStore *mockStorePlanet.MockStore) {
+				filmStore.EXPECT().GetOneByID(gomock.Any(), gomock.Any()).Return(nil, filmModel.ErrorFilmNotFound)
+			},
+			expectedPlanets: nil,
+			expectedErr:     filmModel.ErrorFilmNotFound,
+		},
+		"should return planet not found when the film has no planets": {
+			inputFilm: 1,
+			prepareMock: func(filmStore *mockStoreFilm.MockStore, planetStore *mockStorePlanet.MockStore) {
+				filmStore.EXPECT().GetOneByID(gomock.Any(), gomock.Any()).Return(&filmModel.Film{
+					ID: 1,
+				}, nil)
+				planetStore.EXPECT().GetAllByFilmID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			expectedPlanets: nil,
+			expectedErr:     planetModel.ErrorPlanetNotFound,
+		},
+		"should throw error when get the planets by film id": {
+			inputFilm: 1,
+			prepareMock: func(filmStore *mockStoreFilm.MockStore, planetStore *mockStorePlanet.MockStore) {
+				filmStore.EXPECT().GetOneByID(gomock.Any(), gomock.Any()).Return(&filmModel.Film{
+					ID: 1,
+				}, nil)
+				planetStore.EXPECT().GetAllByFilmID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			expectedPlanets: nil,
+			expectedErr:     fmt.Errorf("error"),
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			// given
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			defer ctrl.Finish()
+
+			filmStoreMock := mockStoreFilm.NewMockStore(ctrl)
+			planetStoreMock := mockStorePlanet.NewMockStore(ctrl)
+
+			cs.prepareMock(filmStoreMock, planetStoreMock)
+			app := NewApp(&store.Container{
+				Film:   filmStoreMock,
+				Planet: planetStoreMock,
+			})
+
+			// when
+			planets, err := app.GetPlanetsByFilmID(ctx, cs.inputFilm, 1, 10)
+
+			// then
+			assert.Equal(t, cs.expectedErr, err)
+			assert.Equal(t, cs.expectedPlanets, planets)
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package people
+
+import (
+	"context"
+
+	genericModel "github.com/danilotadeu/star_wars/model/generic"
+	peopleModel "github.com/danilotadeu/star_wars/model/people"
+	"github.com/danilotadeu/star_wars/store"
+	"github.com/sirupsen/logrus"
+)
+
+//go:generate mockgen -destination ../../mock/app/people/people_app_mock.go -package mockAppPeople . App
+type App interface {
+	GetOneByID(ctx context.Context, peopleID int64) (*peopleModel.PeopleDB, error)
+	GetAllPeople(ctx context.Context, page, limit int64, name string) ([]*peopleModel.PeopleDB, error)
+	GetTotalPeople(ctx context.Context, name string) (*int64, error)
+}
+
+type appImpl struct {
+	store *store.Container
+}
+
+// NewApp init a people
+func NewApp(store *store.Container) App {
+	return &appImpl{
+		store: store,
+	}
+}
+
+func (a *appImpl) GetOneByID(ctx context.Context, peopleID int64) (*peopleModel.PeopleDB, error) {
+	people, err := a.store.People.GetOneByID(ctx, peopleID)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.people.GetOneByID.Store.People.GetOneByID"}).Error(err)
+		return nil, err
+	}
+
+	films, err := a.store.People.GetFilmsByPeopleIDs(ctx, []int64{people.ID})
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.people.GetOneByID.Store.People.GetFilmsByPeopleIDs"}).Error(err)
+		return nil, err
+	}
+
+	for _, film := range films {
+		people.Films = append(people.Films, film.Film)
+	}
+
+	return people, nil
+}
+
+// GetAllPeople list a page, starting at 1, of the people whose name contains name..
+func (a *appImpl) GetAllPeople(ctx context.Context, page, limit int64, name string) ([]*peopleModel.PeopleDB, error) {
+	people, err := a.store.People.GetAll(ctx, genericModel.Offset(page, limit), limit, name)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.people.GetAllPeople.Store.People.GetAll"}).Error(err)
+		return nil, err
+	}
+
+	if len(people) == 0 {
+		return nil, peopleModel.ErrorPeopleNotFound
+	}
+
+	peopleIDs := make([]int64, len(people))
+	for idx, person := range people {
+		peopleIDs[idx] = person.ID
+	}
+
+	films, err := a.store.People.GetFilmsByPeopleIDs(ctx, peopleIDs)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.people.GetAllPeople.Store.People.GetFilmsByPeopleIDs"}).Error(err)
+		return nil, err
+	}
+
+	for _, person := range people {
+		for _, film := range films {
+			if person.ID == film.PeopleID {
+				person.Films = append(person.Films, film.Film)
+			}
+		}
+	}
+
+	return people, nil
+}
+
+func (a *appImpl) GetTotalPeople(ctx context.Context, name string) (*int64, error) {
+	total, err := a.store.People.GetTotalPeople(ctx, name)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.people.GetTotalPeople.Store.People.GetTotalPeople"}).Error(err)
+		return nil, err
+	}
+	return total, nil
+}
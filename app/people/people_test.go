@@ -0,0 +1,283 @@
+package people
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	mockStorePeople "github.com/danilotadeu/star_wars/mock/store/people"
+	filmModel "github.com/danilotadeu/star_wars/model/film"
+	peopleModel "github.com/danilotadeu/star_wars/model/people"
+	"github.com/danilotadeu/star_wars/store"
+	"github.com/golang/mock/gomock"
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestGetAllPeople(t *testing.T) {
+	dateString := "2021-11-22"
+	date, _ := time.Parse("2006-01-02", dateString)
+	var planetID int64 = 1
+	peopleExpected := []*peopleModel.PeopleDB{
+		{
+			ID:        1,
+			Name:      "Luke Skywalker",
+			PlanetID:  &planetID,
+			CreatedAt: date,
+			Films: []filmModel.Film{
+				{
+					ID:          1,
+					Name:        "Film 1",
+					Director:    "Director 1",
+					ReleaseDate: date,
+					CreatedAt:   date,
+				},
+			},
+		},
+		{
+			ID:        2,
+			Name:      "C-3PO",
+			CreatedAt: date,
+		},
+	}
+	cases := map[string]struct {
+		inputPage      int64
+		inputLimit     int64
+		inputName      string
+		prepareMock    func(peopleStore *mockStorePeople.MockStore)
+		expectedPeople []*peopleModel.PeopleDB
+		expectedErr    error
+	}{
+		"should get all people": {
+			inputPage:  3,
+			inputLimit: 5,
+			prepareMock: func(peopleStore *mockStorePeople.MockStore) {
+				peopleStore.EXPECT().GetAll(gomock.Any(), int64(10), int64(5), "").Return([]*peopleModel.PeopleDB{
+					{
+						ID:        1,
+						Name:      "Luke Skywalker",
+						PlanetID:  &planetID,
+						CreatedAt: date,
+					},
+					{
+						ID:        2,
+						Name:      "C-3PO",
+						CreatedAt: date,
+					},
+				}, nil)
+				peopleStore.EXPECT().GetFilmsByPeopleIDs(gomock.Any(), []int64{1, 2}).Return([]peopleModel.FilmPeople{
+					{
+						FilmID:    1,
+						PeopleID:  1,
+						CreatedAt: date,
+						Film: filmModel.Film{
+							ID:          1,
+							Name:        "Film 1",
+							Director:    "Director 1",
+							ReleaseDate: date,
+							CreatedAt:   date,
+						},
+					},
+				}, nil)
+			},
+			expectedPeople: peopleExpected,
+			expectedErr:    nil,
+		},
+		"should return empty people": {
+			inputPage:  1,
+			inputLimit: 5,
+			inputName:  "Luke",
+			prepareMock: func(peopleStore *mockStorePeople.MockStore) {
+				peopleStore.EXPECT().GetAll(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			expectedPeople: nil,
+			expectedErr:    peopleModel.ErrorPeopleNotFound,
+		},
+		"should throw error when get all people": {
+			inputPage:  1,
+			inputLimit: 5,
+			prepareMock: func(peopleStore *mockStorePeople.MockStore) {
+				peopleStore.EXPECT().GetAll(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			expectedPeople: nil,
+			expectedErr:    fmt.Errorf("error"),
+		},
+		"should throw error when get films by people ids": {
+			inputPage:  1,
+			inputLimit: 5,
+			prepareMock: func(peopleStore *mockStorePeople.MockStore) {
+				peopleStore.EXPECT().GetAll(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*peopleModel.PeopleDB{
+					{
+						ID:   1,
+						Name: "Luke Skywalker",
+					},
+				}, nil)
+				peopleStore.EXPECT().GetFilmsByPeopleIDs(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			expectedPeople: nil,
+			expectedErr:    fmt.Errorf("error"),
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			// given
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			defer ctrl.Finish()
+
+			peopleStoreMock := mockStorePeople.NewMockStore(ctrl)
+
+			cs.prepareMock(peopleStoreMock)
+			app := NewApp(&store.Container{
+				People: peopleStoreMock,
+			})
+
+			// when
+			people, err := app.GetAllPeople(ctx, cs.inputPage, cs.inputLimit, cs.inputName)
+
+			// then
+			assert.Equal(t, cs.expectedErr, err)
+			assert.Equal(t, cs.expectedPeople, people)
+		})
+	}
+}
+
+func TestGetOneByID(t *testing.T) {
+	dateString := "2021-11-22"
+	date, _ := time.Parse("2006-01-02", dateString)
+	peopleExpected := peopleModel.PeopleDB{
+		ID:        1,
+		Name:      "Luke Skywalker",
+		CreatedAt: date,
+		Films: []filmModel.Film{
+			{
+				ID:          1,
+				Name:        "Film 1",
+				Director:    "Director 1",
+				ReleaseDate: date,
+				CreatedAt:   date,
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		inputPeople    int64
+		prepareMock    func(peopleStore *mockStorePeople.MockStore)
+		expectedPeople *peopleModel.PeopleDB
+		expectedErr    error
+	}{
+		"should return a people with success": {
+			inputPeople: 1,
+			prepareMock: func(peopleStore *mockStorePeople.MockStore) {
+				peopleStore.EXPECT().GetOneByID(gomock.Any(), int64(1)).Return(&peopleModel.PeopleDB{
+					ID:        1,
+					Name:      "Luke Skywalker",
+					CreatedAt: date,
+				}, nil)
+				peopleStore.EXPECT().GetFilmsByPeopleIDs(gomock.Any(), []int64{1}).Return([]peopleModel.FilmPeople{
+					{
+						FilmID:    1,
+						PeopleID:  1,
+						CreatedAt: date,
+						Film: filmModel.Film{
+							ID:          1,
+							Name:        "Film 1",
+							Director:    "Director 1",
+							ReleaseDate: date,
+							CreatedAt:   date,
+						},
+					},
+				}, nil)
+			},
+			expectedPeople: &peopleExpected,
+			expectedErr:    nil,
+		},
+		"should return people not found": {
+			inputPeople: 1,
+			prepareMock: func(peopleStore *mockStorePeople.MockStore) {
+				peopleStore.EXPECT().GetOneByID(gomock.Any(), gomock.Any()).Return(nil, peopleModel.ErrorPeopleNotFound)
+			},
+			expectedPeople: nil,
+			expectedErr:    peopleModel.ErrorPeopleNotFound,
+		},
+		"should throw error when get the films by people id": {
+			inputPeople: 1,
+			prepareMock: func(peopleStore *mockStorePeople.MockStore) {
+				peopleStore.EXPECT().GetOneByID(gomock.Any(), gomock.Any()).Return(&peopleModel.PeopleDB{
+					ID: 1,
+				}, nil)
+				peopleStore.EXPECT().GetFilmsByPeopleIDs(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			expectedPeople: nil,
+			expectedErr:    fmt.Errorf("error"),
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			// given
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			defer ctrl.Finish()
+
+			peopleStoreMock := mockStorePeople.NewMockStore(ctrl)
+
+			cs.prepareMock(peopleStoreMock)
+			app := NewApp(&store.Container{
+				People: peopleStoreMock,
+			})
+
+			// when
+			peopleDB, err := app.GetOneByID(ctx, cs.inputPeople)
+
+			// then
+			assert.Equal(t, cs.expectedErr, err)
+			assert.Equal(t, peopleDB, cs.expectedPeople)
+		})
+	}
+}
+
+func TestGetTotalPeople(t *testing.T) {
+	var total int64 = 1
+	cases := map[string]struct {
+		prepareMock   func(peopleStore *mockStorePeople.MockStore)
+		expectedTotal *int64
+		expectedErr   error
+	}{
+		"should return a total of people": {
+			prepareMock: func(peopleStore *mockStorePeople.MockStore) {
+				peopleStore.EXPECT().GetTotalPeople(gomock.Any(), "").Return(&total, nil)
+			},
+			expectedTotal: &total,
+			expectedErr:   nil,
+		},
+		"should throw error when get a total": {
+			prepareMock: func(peopleStore *mockStorePeople.MockStore) {
+				peopleStore.EXPECT().GetTotalPeople(gomock.Any(), "").Return(nil, fmt.Errorf("error"))
+			},
+			expectedTotal: nil,
+			expectedErr:   fmt.Errorf("error"),
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			// given
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			defer ctrl.Finish()
+
+			peopleStoreMock := mockStorePeople.NewMockStore(ctrl)
+
+			cs.prepareMock(peopleStoreMock)
+			app := NewApp(&store.Container{
+				People: peopleStoreMock,
+			})
+
+			// when
+			total, err := app.GetTotalPeople(ctx, "")
+
+			// then
+			assert.Equal(t, cs.expectedErr, err)
+			assert.Equal(t, total, cs.expectedTotal)
+		})
+	}
+}
@@ -0,0 +1,288 @@
+package planet
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	filmModel "github.com/danilotadeu/star_wars/model/film"
+	importerModel "github.com/danilotadeu/star_wars/model/importer"
+	peopleModel "github.com/danilotadeu/star_wars/model/people"
+	planetModel "github.com/danilotadeu/star_wars/model/planet"
+	speciesModel "github.com/danilotadeu/star_wars/model/species"
+	starshipModel "github.com/danilotadeu/star_wars/model/starship"
+	vehicleModel "github.com/danilotadeu/star_wars/model/vehicle"
+	"github.com/danilotadeu/star_wars/store"
+	"github.com/sirupsen/logrus"
+)
+
+// CreatePlanetsAndFilms create planets and films..
+//
+// Planet pages, films, people, starships, vehicles and species are fetched in parallel by a pool
+// of options.Workers goroutines, every one of them only once through a registry. The database writes
+// happen afterwards in the order of the api so the result is the same on every run,
+// all inside a single transaction so a failed import keeps the previous data untouched.
+// On a dry run the database is only read and the report lists what would be written.
+func (a *appImpl) CreatePlanetsAndFilms(ctx context.Context, options importerModel.Options) (*importerModel.Report, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	planets, err := a.fetchPlanets(ctx, options.Workers)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.planet.CreatePlanetsAndFilms.fetchPlanets"}).Error(err)
+		return nil, err
+	}
+
+	report := &importerModel.Report{
+		DryRun: options.DryRun,
+	}
+
+	registries := newImportRegistries(a.store, options, report)
+	for _, planet := range planets {
+		registries.films.add(planet.Films...)
+	}
+
+	err = registries.films.fetch(ctx)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.planet.CreatePlanetsAndFilms.films.fetch"}).Error(err)
+		return nil, err
+	}
+
+	for _, planet := range planets {
+		registries.people.add(planet.Residents...)
+	}
+	for _, url := range registries.films.urls {
+		if film, _ := registries.films.lookup(url); film != nil {
+			registries.people.add(film.Characters...)
+			registries.starships.add(film.Starships...)
+			registries.vehicles.add(film.Vehicles...)
+			registries.species.add(film.Species...)
+		}
+	}
+
+	for _, fetch := range []func(ctx context.Context) error{registries.people.fetch, registries.starships.fetch, registries.vehicles.fetch, registries.species.fetch} {
+		err = fetch(ctx)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "app.planet.CreatePlanetsAndFilms.fetch"}).Error(err)
+			return nil, err
+		}
+	}
+
+	save := func(stores *store.Container) error {
+		planetIDs, err := savePlanets(ctx, stores, planets, registries.films, options, report)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "app.planet.CreatePlanetsAndFilms.savePlanets"}).Error(err)
+			return err
+		}
+
+		err = savePeople(ctx, stores, registries.people, registries.films, planetIDs, report)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "app.planet.CreatePlanetsAndFilms.savePeople"}).Error(err)
+			return err
+		}
+
+		return saveFilmCatalog(ctx, stores, registries, report)
+	}
+
+	if options.DryRun {
+		err = save(a.store)
+	} else {
+		err = a.store.Transaction.Run(ctx, func(tx *sql.Tx) error {
+			return save(a.store.WithTx(tx))
+		})
+	}
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.planet.CreatePlanetsAndFilms.save"}).Error(err)
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// savePlanets write the planets, their films and the links between them, recording every change on report.
+// The planets in the trash are skipped, keeping only their films.
+// It returns the database id of every planet keyed by its SWAPI url..
+func savePlanets(ctx context.Context, stores *store.Container, planets []planetModel.Planet, films *registry[filmModel.ResultFilm], options importerModel.Options, report *importerModel.Report) (map[string]*int64, error) {
+	planetResources, filmResources := planetStore(stores), filmStore(stores)
+
+	planetIDs := map[string]*int64{}
+	for _, planet := range planets {
+		planetID, action, err := upsert(ctx, options, planetResources, planet, planet.Name, planet.Edited)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "app.planet.savePlanets.upsert"}).Error(err)
+			return nil, err
+		}
+		report.Record(importerModel.ResourcePlanet, action, planet.Name)
+		planetIDs[planet.URL] = planetID
+
+		for _, url := range planet.Films {
+			film, filmID, err := films.sync(ctx, url, filmResources)
+			if err != nil {
+				logrus.WithFields(logrus.Fields{"trace": "app.planet.savePlanets.films.sync"}).Error(err)
+				return nil, err
+			}
+
+			if film == nil || action == importerModel.ActionSkipped {
+				continue
+			}
+
+			linked, err := link(ctx, planetResources, planetID, filmID, options.DryRun)
+			if err != nil {
+				logrus.WithFields(logrus.Fields{"trace": "app.planet.savePlanets.link"}).Error(err)
+				return nil, err
+			}
+
+			if linked {
+				report.Record(importerModel.ResourceFilmPlanet, importerModel.ActionLinked, planet.Name+" - "+film.Title)
+			}
+		}
+	}
+
+	return planetIDs, nil
+}
+
+// savePeople write the people, linked to their homeworld, and the links between them and the films..
+func savePeople(ctx context.Context, stores *store.Container, people *registry[peopleModel.ResultPeople], films *registry[filmModel.ResultFilm], planetIDs map[string]*int64, report *importerModel.Report) error {
+	peopleResources := peopleStore(stores, planetIDs)
+
+	for _, url := range people.urls {
+		_, _, err := people.sync(ctx, url, peopleResources)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "app.planet.savePeople.people.sync"}).Error(err)
+			return err
+		}
+	}
+
+	return linkFilmResources(ctx, films, people, peopleResources, importerModel.ResourceFilmPeople,
+		func(film filmModel.ResultFilm) []string { return film.Characters },
+		report)
+}
+
+// saveFilmCatalog write the starships, vehicles and species of every film and the links between them..
+func saveFilmCatalog(ctx context.Context, stores *store.Container, registries importRegistries, report *importerModel.Report) error {
+	err := linkFilmResources(ctx, registries.films, registries.starships, catalogStore[starshipModel.ResultStarship, *starshipModel.StarshipDB](stores.Starship),
+		importerModel.ResourceFilmStarship,
+		func(film filmModel.ResultFilm) []string { return film.Starships },
+		report)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.planet.saveFilmCatalog.linkFilmResources.starships"}).Error(err)
+		return err
+	}
+
+	err = linkFilmResources(ctx, registries.films, registries.vehicles, catalogStore[vehicleModel.ResultVehicle, *vehicleModel.VehicleDB](stores.Vehicle),
+		importerModel.ResourceFilmVehicle,
+		func(film filmModel.ResultFilm) []string { return film.Vehicles },
+		report)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.planet.saveFilmCatalog.linkFilmResources.vehicles"}).Error(err)
+		return err
+	}
+
+	err = linkFilmResources(ctx, registries.films, registries.species, catalogStore[speciesModel.ResultSpecies, *speciesModel.SpeciesDB](stores.Species),
+		importerModel.ResourceFilmSpecies,
+		func(film filmModel.ResultFilm) []string { return film.Species },
+		report)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.planet.saveFilmCatalog.linkFilmResources.species"}).Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// linkFilmResources sync on store the resources listed by each film, read with urls,
+// and save the relations between them and the film, recording them as linkResource.
+// The films were already saved by savePlanets, so syncing them only return their ids..
+func linkFilmResources[R any](ctx context.Context, films *registry[filmModel.ResultFilm], resources *registry[R], store resourceStore[R],
+	linkResource importerModel.Resource,
+	urls func(film filmModel.ResultFilm) []string,
+	report *importerModel.Report) error {
+	for _, filmURL := range films.urls {
+		film, filmID := films.lookup(filmURL)
+		if film == nil {
+			continue
+		}
+
+		for _, url := range urls(*film) {
+			result, id, err := resources.sync(ctx, url, store)
+			if err != nil {
+				logrus.WithFields(logrus.Fields{"trace": "app.planet.linkFilmResources.sync"}).Error(err)
+				return err
+			}
+
+			if result == nil {
+				continue
+			}
+
+			linked, err := link(ctx, store, id, filmID, resources.options.DryRun)
+			if err != nil {
+				logrus.WithFields(logrus.Fields{"trace": "app.planet.linkFilmResources.link"}).Error(err)
+				return err
+			}
+
+			if linked {
+				report.Record(linkResource, importerModel.ActionLinked, resources.name(*result)+" - "+film.Title)
+			}
+		}
+	}
+
+	return nil
+}
+
+// editedSince report if the SWAPI edited timestamp is newer than the one stored.
+// The database keeps microseconds, so the api value is truncated before comparing..
+func editedSince(edited time.Time, stored *time.Time) bool {
+	return stored == nil || edited.Truncate(time.Microsecond).After(*stored)
+}
+
+// fetchPlanets get the first page to know how many pages exist and the remaining ones in parallel.
+// Pages missing on SWAPI are skipped..
+func (a *appImpl) fetchPlanets(ctx context.Context, workers int) ([]planetModel.Planet, error) {
+	var firstPage *planetModel.ResultPlanet
+	skipped, err := callUpstream(ctx, "planets page 1", func(ctx context.Context) (err error) {
+		firstPage, err = a.store.Planet.GetPlanetsPage(ctx, 1)
+		return err
+	})
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.planet.fetchPlanets.Store.Planet.GetPlanetsPage"}).Error(err)
+		return nil, err
+	}
+
+	if skipped {
+		return nil, nil
+	}
+
+	totalPages := 1
+	if firstPage.Next != nil && len(firstPage.Results) > 0 {
+		totalPages = (firstPage.Count + len(firstPage.Results) - 1) / len(firstPage.Results)
+	}
+
+	pages := make([]*planetModel.ResultPlanet, totalPages)
+	pages[0] = firstPage
+
+	err = runPool(ctx, workers, totalPages-1, func(ctx context.Context, idx int) error {
+		_, err := callUpstream(ctx, fmt.Sprintf("planets page %d", idx+2), func(ctx context.Context) (err error) {
+			pages[idx+1], err = a.store.Planet.GetPlanetsPage(ctx, idx+2)
+			return err
+		})
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "app.planet.fetchPlanets.Store.Planet.GetPlanetsPage"}).Error(err)
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var planets []planetModel.Planet
+	for _, page := range pages {
+		if page != nil {
+			planets = append(planets, page.Results...)
+		}
+	}
+
+	return planets, nil
+}
@@ -2,7 +2,12 @@ package planet
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
+	filmModel "github.com/danilotadeu/star_wars/model/film"
+	genericModel "github.com/danilotadeu/star_wars/model/generic"
+	importerModel "github.com/danilotadeu/star_wars/model/importer"
 	planetModel "github.com/danilotadeu/star_wars/model/planet"
 	"github.com/danilotadeu/star_wars/store"
 	"github.com/sirupsen/logrus"
@@ -10,12 +15,21 @@ import (
 
 //go:generate mockgen -destination ../../mock/app/planet/planet_app_mock.go -package mockAppPlanet . App
 type App interface {
-	CreatePlanetsAndFilms(ctx context.Context) error
-	SaveFilms(ctx context.Context, films []string, planetID int64) error
+	CreatePlanetsAndFilms(ctx context.Context, options importerModel.Options) (*importerModel.Report, error)
 	GetOneByID(ctx context.Context, planetID int64) (*planetModel.PlanetDB, error)
-	GetAllPlanets(ctx context.Context, page, offset int64, name string) ([]*planetModel.PlanetDB, error)
+	CreatePlanet(ctx context.Context, planet planetModel.PlanetRequest) (*planetModel.PlanetDB, error)
+	UpdatePlanet(ctx context.Context, planetID int64, planet planetModel.PlanetRequest) (*planetModel.PlanetDB, error)
+	PatchPlanet(ctx context.Context, planetID int64, patch planetModel.PlanetPatch) (*planetModel.PlanetDB, error)
+	GetAllPlanets(ctx context.Context, page, limit int64, filter planetModel.Filter) ([]*planetModel.PlanetDB, error)
+	GetPlanetsByCursor(ctx context.Context, cursor *genericModel.Cursor, limit int64, filter planetModel.Filter) ([]*planetModel.PlanetDB, *genericModel.CursorPagination, error)
 	Delete(ctx context.Context, planetID int64) error
-	GetTotalPlanets(ctx context.Context) (*int64, error)
+	GetDeletedPlanets(ctx context.Context, page, limit int64) ([]*planetModel.PlanetDB, error)
+	GetTotalDeletedPlanets(ctx context.Context) (*int64, error)
+	Restore(ctx context.Context, planetID int64) (*planetModel.PlanetDB, error)
+	Purge(ctx context.Context, retention time.Duration) (*planetModel.PurgeReport, error)
+	GetTotalPlanets(ctx context.Context, filter planetModel.Filter) (*int64, error)
+	GetFilmsByPlanetID(ctx context.Context, planetID, page, limit int64) ([]*filmModel.Film, error)
+	GetTotalFilmsByPlanetID(ctx context.Context, planetID int64) (*int64, error)
 }
 
 type appImpl struct {
@@ -29,111 +43,147 @@ func NewApp(store *store.Container) App {
 	}
 }
 
-// CreatePlanetsAndFilms create planets and films..
-func (a *appImpl) CreatePlanetsAndFilms(ctx context.Context) error {
-	planetResults, err := a.store.Planet.GetPlanets(ctx)
+func (a *appImpl) GetOneByID(ctx context.Context, planetID int64) (*planetModel.PlanetDB, error) {
+	planet, err := a.store.Planet.GetOneByID(ctx, planetID)
 	if err != nil {
-		logrus.WithFields(logrus.Fields{"trace": "app.planet.CreatePlanetsAndFilms.Store.Planet.GetPlanets"}).Error(err)
-		return err
+		logrus.WithFields(logrus.Fields{"trace": "app.planet.GetOneByID.Store.Planet.GetOneByID"}).Error(err)
+		return nil, err
 	}
 
-	for _, planetResult := range planetResults {
-		for _, planet := range planetResult.Results {
-			planetExist, err := a.store.Planet.GetOne(ctx, planet.Name)
-			if err != nil {
-				logrus.WithFields(logrus.Fields{"trace": "app.planet.CreatePlanetsAndFilms.Store.Planet.GetOne"}).Error(err)
-				return err
-			}
+	films, err := a.store.Film.GetFilmsByPlanetIDs(ctx, []int64{planet.ID})
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.planet.GetOneByID.Store.Film.GetFilmsByPlanetIDs"}).Error(err)
+		return nil, err
+	}
 
-			var planetID *int64
-			if planetExist != nil {
-				planetID = &planetExist.ID
-			}
+	for _, film := range films {
+		planet.Films = append(planet.Films, film.Film)
+	}
 
-			if planetID == nil {
-				planetID, err = a.store.Planet.SavePlanet(ctx, planet)
-				if err != nil {
-					logrus.WithFields(logrus.Fields{"trace": "app.planet.CreatePlanetsAndFilms.Store.Planet.SavePlanet"}).Error(err)
-					return err
-				}
-			}
+	return planet, nil
+}
 
-			err = a.SaveFilms(ctx, planet.Films, *planetID)
-			if err != nil {
-				logrus.WithFields(logrus.Fields{"trace": "app.planet.CreatePlanetsAndFilms.SaveFilms"}).Error(err)
-				return err
-			}
+// CreatePlanet insert a planet and link it to the films of planet.FilmIDs in a single transaction.
+// It returns ErrorFilmNotFound when one of the films does not exist..
+func (a *appImpl) CreatePlanet(ctx context.Context, planet planetModel.PlanetRequest) (*planetModel.PlanetDB, error) {
+	var planetID int64
+	err := a.store.Transaction.Run(ctx, func(tx *sql.Tx) error {
+		stores := a.store.WithTx(tx)
+
+		id, err := stores.Planet.CreatePlanet(ctx, planet)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "app.planet.CreatePlanet.Store.Planet.CreatePlanet"}).Error(err)
+			return err
 		}
+		planetID = *id
+
+		return linkFilms(ctx, stores, planetID, planet.FilmIDs)
+	})
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.planet.CreatePlanet.Store.Transaction.Run"}).Error(err)
+		return nil, err
 	}
 
-	return nil
+	return a.GetOneByID(ctx, planetID)
 }
 
-func (a *appImpl) SaveFilms(ctx context.Context, films []string, planetID int64) error {
-	filmsResult, err := a.store.Film.GetFilms(ctx, films)
+// UpdatePlanet replace the attributes and the film links of an active planet..
+func (a *appImpl) UpdatePlanet(ctx context.Context, planetID int64, planet planetModel.PlanetRequest) (*planetModel.PlanetDB, error) {
+	err := a.store.Transaction.Run(ctx, func(tx *sql.Tx) error {
+		stores := a.store.WithTx(tx)
+
+		err := stores.Planet.ReplacePlanet(ctx, planetID, planet)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "app.planet.UpdatePlanet.Store.Planet.ReplacePlanet"}).Error(err)
+			return err
+		}
+
+		return replaceFilms(ctx, stores, planetID, planet.FilmIDs)
+	})
 	if err != nil {
-		logrus.WithFields(logrus.Fields{"trace": "app.planet.SaveFilms.Store.Film.GetFilms"}).Error(err)
-		return err
+		logrus.WithFields(logrus.Fields{"trace": "app.planet.UpdatePlanet.Store.Transaction.Run"}).Error(err)
+		return nil, err
 	}
 
-	for _, film := range filmsResult {
-		filmExists, err := a.store.Film.GetOne(ctx, film.Title)
+	return a.GetOneByID(ctx, planetID)
+}
+
+// PatchPlanet merge the patch into an active planet, replacing the film links only when the patch has film_ids..
+func (a *appImpl) PatchPlanet(ctx context.Context, planetID int64, patch planetModel.PlanetPatch) (*planetModel.PlanetDB, error) {
+	err := a.store.Transaction.Run(ctx, func(tx *sql.Tx) error {
+		stores := a.store.WithTx(tx)
+
+		current, err := stores.Planet.GetOneByID(ctx, planetID)
 		if err != nil {
-			logrus.WithFields(logrus.Fields{"trace": "app.planet.SaveFilms.Store.Film.GetOne"}).Error(err)
+			logrus.WithFields(logrus.Fields{"trace": "app.planet.PatchPlanet.Store.Planet.GetOneByID"}).Error(err)
 			return err
 		}
 
-		var filmID *int64
-		if filmExists != nil {
-			filmID = &filmExists.ID
-		} else {
-			filmID, err = a.store.Film.SaveFilm(ctx, film)
-			if err != nil {
-				logrus.WithFields(logrus.Fields{"trace": "app.planet.SaveFilms.Store.Film.SaveFilm"}).Error(err)
-				return err
-			}
+		planet, err := patch.Apply(current.Request())
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "app.planet.PatchPlanet.Apply"}).Error(err)
+			return err
 		}
 
-		filmPlanet, err := a.store.Film.GetFilmWithPlanet(ctx, planetID, *filmID)
+		err = stores.Planet.ReplacePlanet(ctx, planetID, planet)
 		if err != nil {
-			logrus.WithFields(logrus.Fields{"trace": "app.planet.SaveFilms.Store.Film.GetFilmWithPlanet"}).Error(err)
+			logrus.WithFields(logrus.Fields{"trace": "app.planet.PatchPlanet.Store.Planet.ReplacePlanet"}).Error(err)
 			return err
 		}
 
-		if filmPlanet == nil {
-			_, err = a.store.Film.SaveFilmWithPlanet(ctx, planetID, *filmID)
-			if err != nil {
-				logrus.WithFields(logrus.Fields{"trace": "app.planet.SaveFilms.Store.Film.SaveFilmWithPlanet"}).Error(err)
-				return err
-			}
+		if !patch.HasFilms() {
+			return nil
 		}
-	}
-
-	return nil
-}
 
-func (a *appImpl) GetOneByID(ctx context.Context, planetID int64) (*planetModel.PlanetDB, error) {
-	planet, err := a.store.Planet.GetOneByID(ctx, planetID)
+		return replaceFilms(ctx, stores, planetID, planet.FilmIDs)
+	})
 	if err != nil {
-		logrus.WithFields(logrus.Fields{"trace": "app.planet.GetOneByID.Store.Planet.GetOneByID"}).Error(err)
+		logrus.WithFields(logrus.Fields{"trace": "app.planet.PatchPlanet.Store.Transaction.Run"}).Error(err)
 		return nil, err
 	}
 
-	films, err := a.store.Film.GetFilmsByPlanetIDs(ctx, []int64{planet.ID})
+	return a.GetOneByID(ctx, planetID)
+}
+
+// replaceFilms remove the film links of the planet and link it to filmIDs..
+func replaceFilms(ctx context.Context, stores *store.Container, planetID int64, filmIDs []int64) error {
+	err := stores.Film.DeleteFilmsWithPlanet(ctx, planetID)
 	if err != nil {
-		logrus.WithFields(logrus.Fields{"trace": "app.planet.GetOneByID.Store.Film.GetFilmsByPlanetIDs"}).Error(err)
-		return nil, err
+		logrus.WithFields(logrus.Fields{"trace": "app.planet.replaceFilms.Store.Film.DeleteFilmsWithPlanet"}).Error(err)
+		return err
 	}
 
-	for _, film := range films {
-		planet.Films = append(planet.Films, film.Film)
+	return linkFilms(ctx, stores, planetID, filmIDs)
+}
+
+// linkFilms link the planet to every film of filmIDs, ignoring repeated ids..
+func linkFilms(ctx context.Context, stores *store.Container, planetID int64, filmIDs []int64) error {
+	linked := map[int64]bool{}
+	for _, filmID := range filmIDs {
+		if linked[filmID] {
+			continue
+		}
+		linked[filmID] = true
+
+		_, err := stores.Film.GetOneByID(ctx, filmID)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "app.planet.linkFilms.Store.Film.GetOneByID"}).Error(err)
+			return err
+		}
+
+		_, err = stores.Film.SaveFilmWithPlanet(ctx, planetID, filmID)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "app.planet.linkFilms.Store.Film.SaveFilmWithPlanet"}).Error(err)
+			return err
+		}
 	}
 
-	return planet, nil
+	return nil
 }
 
-func (a *appImpl) GetAllPlanets(ctx context.Context, page, offset int64, name string) ([]*planetModel.PlanetDB, error) {
-	planets, err := a.store.Planet.GetAll(ctx, page, offset, name)
+// GetAllPlanets list a page, starting at 1, of the planets matching filter..
+func (a *appImpl) GetAllPlanets(ctx context.Context, page, limit int64, filter planetModel.Filter) ([]*planetModel.PlanetDB, error) {
+	planets, err := a.store.Planet.GetAll(ctx, genericModel.Offset(page, limit), limit, filter)
 	if err != nil {
 		logrus.WithFields(logrus.Fields{"trace": "app.planet.GetAllPlanets.Store.Planet.GetAll"}).Error(err)
 		return nil, err
@@ -143,6 +193,54 @@ func (a *appImpl) GetAllPlanets(ctx context.Context, page, offset int64, name st
 		return nil, planetModel.ErrorPlanetNotFound
 	}
 
+	err = a.attachFilms(ctx, planets)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.planet.GetAllPlanets.attachFilms"}).Error(err)
+		return nil, err
+	}
+
+	return planets, nil
+}
+
+// GetPlanetsByCursor list up to limit planets matching filter after the cursor, or before it when the cursor
+// is backward, nil being the first page. The pagination carries the cursors of the pages around it..
+func (a *appImpl) GetPlanetsByCursor(ctx context.Context, cursor *genericModel.Cursor, limit int64, filter planetModel.Filter) ([]*planetModel.PlanetDB, *genericModel.CursorPagination, error) {
+	if cursor != nil && cursor.Sort != planetModel.FormatSort(filter.Sort) {
+		return nil, nil, genericModel.ErrorInvalidCursor
+	}
+
+	// one planet more than the page tells if there is another page past it
+	planets, err := a.store.Planet.GetAllByCursor(ctx, cursor, limit+1, filter)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.planet.GetPlanetsByCursor.Store.Planet.GetAllByCursor"}).Error(err)
+		return nil, nil, err
+	}
+
+	more := int64(len(planets)) > limit
+	if more {
+		if cursor != nil && cursor.Backward {
+			planets = planets[1:]
+		} else {
+			planets = planets[:limit]
+		}
+	}
+
+	if len(planets) == 0 {
+		return nil, nil, planetModel.ErrorPlanetNotFound
+	}
+
+	err = a.attachFilms(ctx, planets)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.planet.GetPlanetsByCursor.attachFilms"}).Error(err)
+		return nil, nil, err
+	}
+
+	pagination := genericModel.NewCursorPagination(cursor, limit, more, planets[0].Cursor(filter.Sort), planets[len(planets)-1].Cursor(filter.Sort))
+	return planets, &pagination, nil
+}
+
+// attachFilms fill the films of each planet..
+func (a *appImpl) attachFilms(ctx context.Context, planets []*planetModel.PlanetDB) error {
 	planetIDs := make([]int64, len(planets))
 	for idx, planet := range planets {
 		planetIDs[idx] = planet.ID
@@ -150,8 +248,8 @@ func (a *appImpl) GetAllPlanets(ctx context.Context, page, offset int64, name st
 
 	films, err := a.store.Film.GetFilmsByPlanetIDs(ctx, planetIDs)
 	if err != nil {
-		logrus.WithFields(logrus.Fields{"trace": "app.planet.GetAllPlanets.Store.Film.GetFilmsByPlanetIDs"}).Error(err)
-		return nil, err
+		logrus.WithFields(logrus.Fields{"trace": "app.planet.attachFilms.Store.Film.GetFilmsByPlanetIDs"}).Error(err)
+		return err
 	}
 
 	for _, planet := range planets {
@@ -162,7 +260,7 @@ func (a *appImpl) GetAllPlanets(ctx context.Context, page, offset int64, name st
 		}
 	}
 
-	return planets, nil
+	return nil
 }
 
 func (a *appImpl) Delete(ctx context.Context, planetID int64) error {
@@ -180,11 +278,98 @@ func (a *appImpl) Delete(ctx context.Context, planetID int64) error {
 	return nil
 }
 
-func (a *appImpl) GetTotalPlanets(ctx context.Context) (*int64, error) {
-	total, err := a.store.Planet.GetTotalPlanets(ctx)
+// GetDeletedPlanets list the soft deleted planets with the films they will get back when restored..
+func (a *appImpl) GetDeletedPlanets(ctx context.Context, page, limit int64) ([]*planetModel.PlanetDB, error) {
+	planets, err := a.store.Planet.GetAllDeleted(ctx, genericModel.Offset(page, limit), limit)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.planet.GetDeletedPlanets.Store.Planet.GetAllDeleted"}).Error(err)
+		return nil, err
+	}
+
+	if len(planets) == 0 {
+		return nil, planetModel.ErrorPlanetNotFound
+	}
+
+	err = a.attachFilms(ctx, planets)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.planet.GetDeletedPlanets.attachFilms"}).Error(err)
+		return nil, err
+	}
+
+	return planets, nil
+}
+
+func (a *appImpl) GetTotalDeletedPlanets(ctx context.Context) (*int64, error) {
+	total, err := a.store.Planet.GetTotalDeletedPlanets(ctx)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.planet.GetTotalDeletedPlanets.Store.Planet.GetTotalDeletedPlanets"}).Error(err)
+		return nil, err
+	}
+	return total, nil
+}
+
+// Restore bring back a soft deleted planet together with its film links..
+func (a *appImpl) Restore(ctx context.Context, planetID int64) (*planetModel.PlanetDB, error) {
+	err := a.store.Transaction.Run(ctx, func(tx *sql.Tx) error {
+		stores := a.store.WithTx(tx)
+
+		planet, err := stores.Planet.GetDeletedByID(ctx, planetID)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "app.planet.Restore.Store.Planet.GetDeletedByID"}).Error(err)
+			return err
+		}
+
+		err = stores.Planet.Restore(ctx, planet.ID)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "app.planet.Restore.Store.Planet.Restore"}).Error(err)
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.planet.Restore.Store.Transaction.Run"}).Error(err)
+		return nil, err
+	}
+
+	return a.GetOneByID(ctx, planetID)
+}
+
+func (a *appImpl) GetTotalPlanets(ctx context.Context, filter planetModel.Filter) (*int64, error) {
+	total, err := a.store.Planet.GetTotalPlanets(ctx, filter)
 	if err != nil {
 		logrus.WithFields(logrus.Fields{"trace": "app.planet.GetTotalPlanets.Store.Planet.GetTotalPlanets"}).Error(err)
 		return nil, err
 	}
 	return total, nil
 }
+
+// GetFilmsByPlanetID list the films of a planet, ErrorPlanetNotFound when the planet does not exist..
+func (a *appImpl) GetFilmsByPlanetID(ctx context.Context, planetID, page, limit int64) ([]*filmModel.Film, error) {
+	planet, err := a.store.Planet.GetOneByID(ctx, planetID)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.planet.GetFilmsByPlanetID.Store.Planet.GetOneByID"}).Error(err)
+		return nil, err
+	}
+
+	films, err := a.store.Film.GetAllByPlanetID(ctx, planet.ID, genericModel.Offset(page, limit), limit)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.planet.GetFilmsByPlanetID.Store.Film.GetAllByPlanetID"}).Error(err)
+		return nil, err
+	}
+
+	if len(films) == 0 {
+		return nil, filmModel.ErrorFilmNotFound
+	}
+
+	return films, nil
+}
+
+func (a *appImpl) GetTotalFilmsByPlanetID(ctx context.Context, planetID int64) (*int64, error) {
+	total, err := a.store.Film.GetTotalFilmsByPlanetID(ctx, planetID)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.planet.GetTotalFilmsByPlanetID.Store.Film.GetTotalFilmsByPlanetID"}).Error(err)
+		return nil, err
+	}
+	return total, nil
+}
@@ -2,89 +2,301 @@ package planet
 
 import (
 	"context"
+	"database/sql"
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
 
 	mockStoreFilm "github.com/danilotadeu/star_wars/mock/store/film"
+	mockStorePeople "github.com/danilotadeu/star_wars/mock/store/people"
 	mockStorePlanet "github.com/danilotadeu/star_wars/mock/store/planet"
+	mockStoreSpecies "github.com/danilotadeu/star_wars/mock/store/species"
+	mockStoreStarship "github.com/danilotadeu/star_wars/mock/store/starship"
+	mockStoreTransaction "github.com/danilotadeu/star_wars/mock/store/transaction"
+	mockStoreVehicle "github.com/danilotadeu/star_wars/mock/store/vehicle"
 	filmModel "github.com/danilotadeu/star_wars/model/film"
+	genericModel "github.com/danilotadeu/star_wars/model/generic"
+	importerModel "github.com/danilotadeu/star_wars/model/importer"
+	peopleModel "github.com/danilotadeu/star_wars/model/people"
 	planetModel "github.com/danilotadeu/star_wars/model/planet"
+	resourceModel "github.com/danilotadeu/star_wars/model/resource"
+	speciesModel "github.com/danilotadeu/star_wars/model/species"
+	starshipModel "github.com/danilotadeu/star_wars/model/starship"
+	swapiModel "github.com/danilotadeu/star_wars/model/swapi"
+	vehicleModel "github.com/danilotadeu/star_wars/model/vehicle"
 	"github.com/danilotadeu/star_wars/store"
 	"github.com/golang/mock/gomock"
 	"gopkg.in/go-playground/assert.v1"
 )
 
 func TestCreatePlanetsAndFilms(t *testing.T) {
+	pageOne := &planetModel.ResultPlanet{
+		Count:    3,
+		Next:     new(string),
+		Previous: nil,
+		Results: []planetModel.Planet{
+			{
+				Name:  "Planet 1",
+				Films: []string{"film/1", "film/2"},
+			},
+			{
+				Name:  "Planet 2",
+				Films: []string{"film/1"},
+			},
+		},
+	}
+	pageTwo := &planetModel.ResultPlanet{
+		Count:    3,
+		Next:     nil,
+		Previous: nil,
+		Results: []planetModel.Planet{
+			{
+				Name:  "Planet 3",
+				Films: []string{"film/2"},
+			},
+		},
+	}
+
+	edited := time.Date(2014, 12, 20, 20, 58, 18, 411000000, time.UTC)
+	before := edited.Add(-time.Hour)
+	malformedErr := &swapiModel.UpstreamError{
+		Err:        swapiModel.ErrorMalformedBody,
+		StatusCode: 200,
+	}
+	rateLimitedErr := &swapiModel.UpstreamError{
+		Err:        swapiModel.ErrorRateLimited,
+		StatusCode: 429,
+		RetryAfter: time.Millisecond,
+	}
+
 	cases := map[string]struct {
-		prepareMock func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore)
-		expectedErr error
+		incremental    bool
+		dryRun         bool
+		transactionErr error
+		prepareMock    func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore, peopleStore *mockStorePeople.MockStore)
+		prepareCatalog func(starshipStore *mockStoreStarship.MockStore, vehicleStore *mockStoreVehicle.MockStore, speciesStore *mockStoreSpecies.MockStore)
+		expectedReport *importerModel.Report
+		expectedErr    error
 	}{
 		"should save planet and films": {
-			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
-				planetStore.EXPECT().GetPlanets(gomock.Any()).Return([]planetModel.ResultPlanet{
-					{
-						Count:    60,
-						Next:     new(string),
-						Previous: nil,
-						Results: []planetModel.Planet{
-							{
-								Name: "Planet 1",
-							},
-							{
-								Name: "Planet 2",
-							},
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore, peopleStore *mockStorePeople.MockStore) {
+				planetStore.EXPECT().GetPlanetsPage(gomock.Any(), 1).Return(pageOne, nil)
+				planetStore.EXPECT().GetPlanetsPage(gomock.Any(), 2).Return(pageTwo, nil)
+				filmStore.EXPECT().GetFilm(gomock.Any(), "film/1").Times(1).Return(&filmModel.ResultFilm{
+					Title: "Film 1",
+				}, nil)
+				filmStore.EXPECT().GetFilm(gomock.Any(), "film/2").Times(1).Return(&filmModel.ResultFilm{
+					Title: "Film 2",
+				}, nil)
+				gomock.InOrder(
+					planetStore.EXPECT().GetOne(gomock.Any(), "Planet 1").Return(nil, nil),
+					planetStore.EXPECT().GetOne(gomock.Any(), "Planet 2").Return(nil, nil),
+					planetStore.EXPECT().GetOne(gomock.Any(), "Planet 3").Return(nil, nil),
+				)
+				planetStore.EXPECT().GetDeletedByName(gomock.Any(), gomock.Any()).Times(3).Return(nil, nil)
+				var planetID int64 = 1
+				planetStore.EXPECT().SavePlanet(gomock.Any(), gomock.Any()).Times(3).Return(&planetID, nil)
+				filmStore.EXPECT().GetOne(gomock.Any(), gomock.Any()).Times(2).Return(nil, nil)
+				var filmID int64 = 1
+				filmStore.EXPECT().SaveFilm(gomock.Any(), gomock.Any()).Times(2).Return(&filmID, nil)
+				filmStore.EXPECT().GetFilmWithPlanet(gomock.Any(), gomock.Any(), gomock.Any()).Times(4).Return(nil, nil)
+				var filmPlanetID int64 = 1
+				filmStore.EXPECT().SaveFilmWithPlanet(gomock.Any(), gomock.Any(), gomock.Any()).Times(4).Return(&filmPlanetID, nil)
+			},
+			expectedReport: &importerModel.Report{
+				Planets: importerModel.Counts{Created: 3},
+				Films:   importerModel.Counts{Created: 2},
+				Links:   4,
+				Changes: []importerModel.Change{
+					{Resource: importerModel.ResourcePlanet, Action: importerModel.ActionCreated, Name: "Planet 1"},
+					{Resource: importerModel.ResourceFilm, Action: importerModel.ActionCreated, Name: "Film 1"},
+					{Resource: importerModel.ResourceFilmPlanet, Action: importerModel.ActionLinked, Name: "Planet 1 - Film 1"},
+					{Resource: importerModel.ResourceFilm, Action: importerModel.ActionCreated, Name: "Film 2"},
+					{Resource: importerModel.ResourceFilmPlanet, Action: importerModel.ActionLinked, Name: "Planet 1 - Film 2"},
+					{Resource: importerModel.ResourcePlanet, Action: importerModel.ActionCreated, Name: "Planet 2"},
+					{Resource: importerModel.ResourceFilmPlanet, Action: importerModel.ActionLinked, Name: "Planet 2 - Film 1"},
+					{Resource: importerModel.ResourcePlanet, Action: importerModel.ActionCreated, Name: "Planet 3"},
+					{Resource: importerModel.ResourceFilmPlanet, Action: importerModel.ActionLinked, Name: "Planet 3 - Film 2"},
+				},
+			},
+			expectedErr: nil,
+		},
+		"should save people linked to their homeworld and films": {
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore, peopleStore *mockStorePeople.MockStore) {
+				planetStore.EXPECT().GetPlanetsPage(gomock.Any(), 1).Return(&planetModel.ResultPlanet{
+					Count: 1,
+					Results: []planetModel.Planet{
+						{
+							Name:      "Tatooine",
+							Films:     []string{"film/1"},
+							Residents: []string{"people/1"},
+							URL:       "planets/1",
 						},
 					},
 				}, nil)
-				planetStore.EXPECT().GetOne(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, nil)
+				filmStore.EXPECT().GetFilm(gomock.Any(), "film/1").Return(&filmModel.ResultFilm{
+					Title:      "Film 1",
+					Characters: []string{"people/1", "people/2"},
+				}, nil)
+				peopleStore.EXPECT().GetPeople(gomock.Any(), "people/1").Return(&peopleModel.ResultPeople{
+					Name:      "Luke Skywalker",
+					Homeworld: "planets/1",
+				}, nil)
+				peopleStore.EXPECT().GetPeople(gomock.Any(), "people/2").Return(&peopleModel.ResultPeople{
+					Name:      "Leia Organa",
+					Homeworld: "planets/2",
+				}, nil)
 				var planetID int64 = 1
-				planetStore.EXPECT().SavePlanet(gomock.Any(), gomock.Any()).AnyTimes().Return(&planetID, nil)
-				filmStore.EXPECT().GetFilms(gomock.Any(), gomock.Any()).AnyTimes().Return([]filmModel.ResultFilm{
-					{
-						Title: "Film 1",
-					},
-					{
-						Title: "Film 2",
+				planetStore.EXPECT().GetOne(gomock.Any(), "Tatooine").Return(nil, nil)
+				planetStore.EXPECT().GetDeletedByName(gomock.Any(), "Tatooine").Return(nil, nil)
+				planetStore.EXPECT().SavePlanet(gomock.Any(), gomock.Any()).Return(&planetID, nil)
+				var filmID int64 = 1
+				filmStore.EXPECT().GetOne(gomock.Any(), "Film 1").Return(nil, nil)
+				filmStore.EXPECT().SaveFilm(gomock.Any(), gomock.Any()).Return(&filmID, nil)
+				filmStore.EXPECT().GetFilmWithPlanet(gomock.Any(), planetID, filmID).Return(nil, nil)
+				filmStore.EXPECT().SaveFilmWithPlanet(gomock.Any(), planetID, filmID).Return(new(int64), nil)
+				var lukeID, leiaID int64 = 1, 2
+				peopleStore.EXPECT().GetOne(gomock.Any(), "Luke Skywalker").Return(nil, nil)
+				peopleStore.EXPECT().SavePeople(gomock.Any(), gomock.Any(), &planetID).Return(&lukeID, nil)
+				peopleStore.EXPECT().GetOne(gomock.Any(), "Leia Organa").Return(&peopleModel.PeopleDB{
+					ID: leiaID,
+				}, nil)
+				peopleStore.EXPECT().GetFilmWithPeople(gomock.Any(), lukeID, filmID).Return(nil, nil)
+				peopleStore.EXPECT().SaveFilmWithPeople(gomock.Any(), lukeID, filmID).Return(nil)
+				peopleStore.EXPECT().GetFilmWithPeople(gomock.Any(), leiaID, filmID).Return(&peopleModel.FilmPeople{
+					FilmID:   filmID,
+					PeopleID: leiaID,
+				}, nil)
+			},
+			expectedReport: &importerModel.Report{
+				Planets: importerModel.Counts{Created: 1},
+				Films:   importerModel.Counts{Created: 1},
+				People:  importerModel.Counts{Created: 1, Unchanged: 1},
+				Links:   2,
+				Changes: []importerModel.Change{
+					{Resource: importerModel.ResourcePlanet, Action: importerModel.ActionCreated, Name: "Tatooine"},
+					{Resource: importerModel.ResourceFilm, Action: importerModel.ActionCreated, Name: "Film 1"},
+					{Resource: importerModel.ResourceFilmPlanet, Action: importerModel.ActionLinked, Name: "Tatooine - Film 1"},
+					{Resource: importerModel.ResourcePeople, Action: importerModel.ActionCreated, Name: "Luke Skywalker"},
+					{Resource: importerModel.ResourceFilmPeople, Action: importerModel.ActionLinked, Name: "Luke Skywalker - Film 1"},
+				},
+			},
+			expectedErr: nil,
+		},
+		"should save the starships, vehicles and species of the films": {
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore, peopleStore *mockStorePeople.MockStore) {
+				planetStore.EXPECT().GetPlanetsPage(gomock.Any(), 1).Return(&planetModel.ResultPlanet{
+					Count: 1,
+					Results: []planetModel.Planet{
+						{
+							Name:  "Hoth",
+							Films: []string{"film/2"},
+						},
 					},
 				}, nil)
-				filmStore.EXPECT().GetOne(gomock.Any(), gomock.Any()).AnyTimes().Return(&filmModel.Film{
+				filmStore.EXPECT().GetFilm(gomock.Any(), "film/2").Return(&filmModel.ResultFilm{
+					Title:     "Film 2",
+					Starships: []string{"starships/12"},
+					Vehicles:  []string{"vehicles/14", "vehicles/18"},
+					Species:   []string{"species/3"},
+				}, nil)
+				planetStore.EXPECT().GetOne(gomock.Any(), "Hoth").Return(&planetModel.PlanetDB{
 					ID: 1,
 				}, nil)
-				filmStore.EXPECT().GetFilmWithPlanet(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil, nil)
-				var filmPlanetID int64 = 1
-				filmStore.EXPECT().SaveFilmWithPlanet(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(&filmPlanetID, nil)
+				filmStore.EXPECT().GetOne(gomock.Any(), "Film 2").Return(&filmModel.Film{
+					ID: 2,
+				}, nil)
+				filmStore.EXPECT().GetFilmWithPlanet(gomock.Any(), int64(1), int64(2)).Return(&filmModel.FilmPlanet{
+					FilmID:   2,
+					PlanetID: 1,
+				}, nil)
+			},
+			prepareCatalog: func(starshipStore *mockStoreStarship.MockStore, vehicleStore *mockStoreVehicle.MockStore, speciesStore *mockStoreSpecies.MockStore) {
+				starshipStore.EXPECT().Get(gomock.Any(), "starships/12").Return(&starshipModel.ResultStarship{
+					Name: "X-wing",
+				}, nil)
+				vehicleStore.EXPECT().Get(gomock.Any(), "vehicles/14").Return(&vehicleModel.ResultVehicle{
+					Name: "Snowspeeder",
+				}, nil)
+				vehicleStore.EXPECT().Get(gomock.Any(), "vehicles/18").Return(nil, &swapiModel.UpstreamError{
+					Err:        swapiModel.ErrorNotFound,
+					StatusCode: 404,
+				})
+				speciesStore.EXPECT().Get(gomock.Any(), "species/3").Return(&speciesModel.ResultSpecies{
+					Name: "Wookie",
+				}, nil)
+				var starshipID, vehicleID, speciesID int64 = 1, 2, 3
+				starshipStore.EXPECT().GetOne(gomock.Any(), "X-wing").Return(nil, nil)
+				starshipStore.EXPECT().Save(gomock.Any(), gomock.Any()).Return(&starshipID, nil)
+				starshipStore.EXPECT().GetFilm(gomock.Any(), starshipID, int64(2)).Return(nil, nil)
+				starshipStore.EXPECT().SaveFilm(gomock.Any(), starshipID, int64(2)).Return(nil)
+				vehicleStore.EXPECT().GetOne(gomock.Any(), "Snowspeeder").Return(&vehicleModel.VehicleDB{
+					ID: vehicleID,
+				}, nil)
+				vehicleStore.EXPECT().GetFilm(gomock.Any(), vehicleID, int64(2)).Return(&resourceModel.FilmLink{
+					ResourceID: vehicleID,
+					FilmID:     2,
+				}, nil)
+				speciesStore.EXPECT().GetOne(gomock.Any(), "Wookie").Return(nil, nil)
+				speciesStore.EXPECT().Save(gomock.Any(), gomock.Any()).Return(&speciesID, nil)
+				speciesStore.EXPECT().GetFilm(gomock.Any(), speciesID, int64(2)).Return(nil, nil)
+				speciesStore.EXPECT().SaveFilm(gomock.Any(), speciesID, int64(2)).Return(nil)
+			},
+			expectedReport: &importerModel.Report{
+				Planets:   importerModel.Counts{Unchanged: 1},
+				Films:     importerModel.Counts{Unchanged: 1},
+				Starships: importerModel.Counts{Created: 1},
+				Vehicles:  importerModel.Counts{Unchanged: 1},
+				Species:   importerModel.Counts{Created: 1},
+				Links:     2,
+				Changes: []importerModel.Change{
+					{Resource: importerModel.ResourceStarship, Action: importerModel.ActionCreated, Name: "X-wing"},
+					{Resource: importerModel.ResourceFilmStarship, Action: importerModel.ActionLinked, Name: "X-wing - Film 2"},
+					{Resource: importerModel.ResourceSpecies, Action: importerModel.ActionCreated, Name: "Wookie"},
+					{Resource: importerModel.ResourceFilmSpecies, Action: importerModel.ActionLinked, Name: "Wookie - Film 2"},
+				},
+			},
+			expectedErr: nil,
+		},
+		"should skip films not returned by the api": {
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore, peopleStore *mockStorePeople.MockStore) {
+				planetStore.EXPECT().GetPlanetsPage(gomock.Any(), 1).Return(pageOne, nil)
+				planetStore.EXPECT().GetPlanetsPage(gomock.Any(), 2).Return(pageTwo, nil)
+				filmStore.EXPECT().GetFilm(gomock.Any(), "film/1").Return(&filmModel.ResultFilm{
+					Title: "Film 1",
+				}, nil)
+				filmStore.EXPECT().GetFilm(gomock.Any(), "film/2").Return(nil, &swapiModel.UpstreamError{
+					Err:        swapiModel.ErrorNotFound,
+					StatusCode: 404,
+				})
+				planetStore.EXPECT().GetOne(gomock.Any(), gomock.Any()).Times(3).Return(&planetModel.PlanetDB{
+					ID: 1,
+				}, nil)
+				filmStore.EXPECT().GetOne(gomock.Any(), "Film 1").Times(1).Return(&filmModel.Film{
+					ID: 1,
+				}, nil)
+				filmStore.EXPECT().GetFilmWithPlanet(gomock.Any(), gomock.Any(), gomock.Any()).Times(2).Return(&filmModel.FilmPlanet{
+					FilmID:   1,
+					PlanetID: 1,
+				}, nil)
+			},
+			expectedReport: &importerModel.Report{
+				Planets: importerModel.Counts{Unchanged: 3},
+				Films:   importerModel.Counts{Unchanged: 1},
 			},
 			expectedErr: nil,
 		},
 		"should save planet and films when planet exist": {
-			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
-				planetStore.EXPECT().GetPlanets(gomock.Any()).Return([]planetModel.ResultPlanet{
-					{
-						Count:    60,
-						Next:     new(string),
-						Previous: nil,
-						Results: []planetModel.Planet{
-							{
-								Name: "Planet 1",
-							},
-							{
-								Name: "Planet 2",
-							},
-						},
-					},
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore, peopleStore *mockStorePeople.MockStore) {
+				planetStore.EXPECT().GetPlanetsPage(gomock.Any(), 1).Return(pageOne, nil)
+				planetStore.EXPECT().GetPlanetsPage(gomock.Any(), 2).Return(pageTwo, nil)
+				filmStore.EXPECT().GetFilm(gomock.Any(), gomock.Any()).AnyTimes().Return(&filmModel.ResultFilm{
+					Title: "Film 1",
 				}, nil)
 				planetStore.EXPECT().GetOne(gomock.Any(), gomock.Any()).AnyTimes().Return(&planetModel.PlanetDB{
 					ID: 1,
 				}, nil)
-				filmStore.EXPECT().GetFilms(gomock.Any(), gomock.Any()).AnyTimes().Return([]filmModel.ResultFilm{
-					{
-						Title: "Film 1",
-					},
-					{
-						Title: "Film 2",
-					},
-				}, nil)
 				filmStore.EXPECT().GetOne(gomock.Any(), gomock.Any()).AnyTimes().Return(&filmModel.Film{
 					ID: 1,
 				}, nil)
@@ -92,260 +304,278 @@ func TestCreatePlanetsAndFilms(t *testing.T) {
 				var filmPlanetID int64 = 1
 				filmStore.EXPECT().SaveFilmWithPlanet(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(&filmPlanetID, nil)
 			},
-			expectedErr: nil,
-		},
-		"should throw error when get planets": {
-			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
-				planetStore.EXPECT().GetPlanets(gomock.Any()).Return(nil, fmt.Errorf("error"))
+			expectedReport: &importerModel.Report{
+				Planets: importerModel.Counts{Unchanged: 3},
+				Films:   importerModel.Counts{Unchanged: 2},
+				Links:   4,
+				Changes: []importerModel.Change{
+					{Resource: importerModel.ResourceFilmPlanet, Action: importerModel.ActionLinked, Name: "Planet 1 - Film 1"},
+					{Resource: importerModel.ResourceFilmPlanet, Action: importerModel.ActionLinked, Name: "Planet 1 - Film 1"},
+					{Resource: importerModel.ResourceFilmPlanet, Action: importerModel.ActionLinked, Name: "Planet 2 - Film 1"},
+					{Resource: importerModel.ResourceFilmPlanet, Action: importerModel.ActionLinked, Name: "Planet 3 - Film 1"},
+				},
 			},
-			expectedErr: fmt.Errorf("error"),
+			expectedErr: nil,
 		},
-		"should throw error when get one": {
-			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
-				planetStore.EXPECT().GetPlanets(gomock.Any()).Return([]planetModel.ResultPlanet{
-					{
-						Count:    60,
-						Next:     new(string),
-						Previous: nil,
-						Results: []planetModel.Planet{
-							{
-								Name: "Planet 1",
-							},
-							{
-								Name: "Planet 2",
-							},
+		"should update planets and films edited since the last import": {
+			incremental: true,
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore, peopleStore *mockStorePeople.MockStore) {
+				planetStore.EXPECT().GetPlanetsPage(gomock.Any(), 1).Return(&planetModel.ResultPlanet{
+					Count: 2,
+					Results: []planetModel.Planet{
+						{
+							Name:   "Planet 1",
+							Films:  []string{"film/1"},
+							Edited: edited,
 						},
-					},
-				}, nil)
-				planetStore.EXPECT().GetOne(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, fmt.Errorf("error"))
-
-			},
-			expectedErr: fmt.Errorf("error"),
-		},
-		"should throw error when save planet": {
-			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
-				planetStore.EXPECT().GetPlanets(gomock.Any()).Return([]planetModel.ResultPlanet{
-					{
-						Count:    60,
-						Next:     new(string),
-						Previous: nil,
-						Results: []planetModel.Planet{
-							{
-								Name: "Planet 1",
-							},
-							{
-								Name: "Planet 2",
-							},
+						{
+							Name:   "Planet 2",
+							Films:  []string{"film/2"},
+							Edited: edited,
 						},
 					},
 				}, nil)
-				planetStore.EXPECT().GetOne(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, nil)
-				planetStore.EXPECT().SavePlanet(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, fmt.Errorf("error"))
+				filmStore.EXPECT().GetFilm(gomock.Any(), "film/1").Return(&filmModel.ResultFilm{
+					Title:  "Film 1",
+					Edited: edited,
+				}, nil)
+				filmStore.EXPECT().GetFilm(gomock.Any(), "film/2").Return(&filmModel.ResultFilm{
+					Title:  "Film 2",
+					Edited: edited,
+				}, nil)
+				storedEdited := edited.Truncate(time.Microsecond)
+				planetStore.EXPECT().GetOne(gomock.Any(), "Planet 1").Return(&planetModel.PlanetDB{
+					ID:       1,
+					EditedAt: &before,
+				}, nil)
+				planetStore.EXPECT().GetOne(gomock.Any(), "Planet 2").Return(&planetModel.PlanetDB{
+					ID:       2,
+					EditedAt: &storedEdited,
+				}, nil)
+				planetStore.EXPECT().UpdatePlanet(gomock.Any(), int64(1), gomock.Any()).Return(nil)
+				filmStore.EXPECT().GetOne(gomock.Any(), "Film 1").Return(&filmModel.Film{
+					ID: 1,
+				}, nil)
+				filmStore.EXPECT().GetOne(gomock.Any(), "Film 2").Return(&filmModel.Film{
+					ID:       2,
+					EditedAt: &storedEdited,
+				}, nil)
+				filmStore.EXPECT().UpdateFilm(gomock.Any(), int64(1), gomock.Any()).Return(nil)
+				filmStore.EXPECT().GetFilmWithPlanet(gomock.Any(), gomock.Any(), gomock.Any()).Times(2).Return(&filmModel.FilmPlanet{}, nil)
 			},
-			expectedErr: fmt.Errorf("error"),
+			expectedReport: &importerModel.Report{
+				Planets: importerModel.Counts{Updated: 1, Unchanged: 1},
+				Films:   importerModel.Counts{Updated: 1, Unchanged: 1},
+				Changes: []importerModel.Change{
+					{Resource: importerModel.ResourcePlanet, Action: importerModel.ActionUpdated, Name: "Planet 1"},
+					{Resource: importerModel.ResourceFilm, Action: importerModel.ActionUpdated, Name: "Film 1"},
+				},
+			},
+			expectedErr: nil,
 		},
-		"should throw error when save films": {
-			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
-				planetStore.EXPECT().GetPlanets(gomock.Any()).Return([]planetModel.ResultPlanet{
-					{
-						Count:    60,
-						Next:     new(string),
-						Previous: nil,
-						Results: []planetModel.Planet{
-							{
-								Name: "Planet 1",
-							},
-							{
-								Name: "Planet 2",
-							},
+		"should only report the changes on a dry run": {
+			incremental: true,
+			dryRun:      true,
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore, peopleStore *mockStorePeople.MockStore) {
+				planetStore.EXPECT().GetPlanetsPage(gomock.Any(), 1).Return(&planetModel.ResultPlanet{
+					Count: 2,
+					Results: []planetModel.Planet{
+						{
+							Name:   "Planet 1",
+							Films:  []string{"film/1", "film/2"},
+							Edited: edited,
+						},
+						{
+							Name:   "Planet 2",
+							Films:  []string{"film/1"},
+							Edited: edited,
 						},
 					},
 				}, nil)
-				planetStore.EXPECT().GetOne(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, nil)
-				var planetID int64 = 1
-				planetStore.EXPECT().SavePlanet(gomock.Any(), gomock.Any()).AnyTimes().Return(&planetID, nil)
-				filmStore.EXPECT().GetFilms(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, fmt.Errorf("error"))
-			},
-			expectedErr: fmt.Errorf("error"),
-		},
-	}
-
-	for name, cs := range cases {
-		t.Run(name, func(t *testing.T) {
-			// given
-			ctrl, ctx := gomock.WithContext(context.Background(), t)
-			defer ctrl.Finish()
-
-			filmStoreMock := mockStoreFilm.NewMockStore(ctrl)
-			planetStoreMock := mockStorePlanet.NewMockStore(ctrl)
-
-			cs.prepareMock(planetStoreMock, filmStoreMock)
-			app := NewApp(&store.Container{
-				Film:   filmStoreMock,
-				Planet: planetStoreMock,
-			})
-
-			// when
-			err := app.CreatePlanetsAndFilms(ctx)
-
-			// then
-			assert.Equal(t, cs.expectedErr, err)
-		})
-	}
-}
-
-func TestSaveFilms(t *testing.T) {
-	cases := map[string]struct {
-		inputFilms      []string
-		inputPlanetID   int64
-		prepareMock     func(filmStore *mockStoreFilm.MockStore)
-		expectedPlanets []*planetModel.PlanetDB
-		expectedErr     error
-	}{
-		"should save films already exists in table films": {
-			inputFilms:    []string{"1", "2"},
-			inputPlanetID: 5,
-			prepareMock: func(filmStore *mockStoreFilm.MockStore) {
-				filmStore.EXPECT().GetFilms(gomock.Any(), gomock.Any()).Return([]filmModel.ResultFilm{
-					{
-						Title: "Film 1",
-					},
-					{
-						Title: "Film 2",
-					},
+				filmStore.EXPECT().GetFilm(gomock.Any(), "film/1").Return(&filmModel.ResultFilm{
+					Title:  "Film 1",
+					Edited: edited,
 				}, nil)
-				filmStore.EXPECT().GetOne(gomock.Any(), gomock.Any()).AnyTimes().Return(&filmModel.Film{
+				filmStore.EXPECT().GetFilm(gomock.Any(), "film/2").Return(&filmModel.ResultFilm{
+					Title:  "Film 2",
+					Edited: edited,
+				}, nil)
+				planetStore.EXPECT().GetOne(gomock.Any(), "Planet 1").Return(&planetModel.PlanetDB{
+					ID:       1,
+					EditedAt: &before,
+				}, nil)
+				planetStore.EXPECT().GetOne(gomock.Any(), "Planet 2").Return(nil, nil)
+				planetStore.EXPECT().GetDeletedByName(gomock.Any(), "Planet 2").Return(nil, nil)
+				filmStore.EXPECT().GetOne(gomock.Any(), "Film 1").Return(&filmModel.Film{
 					ID: 1,
 				}, nil)
-				filmStore.EXPECT().GetFilmWithPlanet(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil, nil)
-				var filmPlanetID int64 = 1
-				filmStore.EXPECT().SaveFilmWithPlanet(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(&filmPlanetID, nil)
+				filmStore.EXPECT().GetOne(gomock.Any(), "Film 2").Return(nil, nil)
+				filmStore.EXPECT().GetFilmWithPlanet(gomock.Any(), int64(1), int64(1)).Return(nil, nil)
+			},
+			expectedReport: &importerModel.Report{
+				DryRun:  true,
+				Planets: importerModel.Counts{Created: 1, Updated: 1},
+				Films:   importerModel.Counts{Created: 1, Updated: 1},
+				Links:   3,
+				Changes: []importerModel.Change{
+					{Resource: importerModel.ResourcePlanet, Action: importerModel.ActionUpdated, Name: "Planet 1"},
+					{Resource: importerModel.ResourceFilm, Action: importerModel.ActionUpdated, Name: "Film 1"},
+					{Resource: importerModel.ResourceFilmPlanet, Action: importerModel.ActionLinked, Name: "Planet 1 - Film 1"},
+					{Resource: importerModel.ResourceFilm, Action: importerModel.ActionCreated, Name: "Film 2"},
+					{Resource: importerModel.ResourceFilmPlanet, Action: importerModel.ActionLinked, Name: "Planet 1 - Film 2"},
+					{Resource: importerModel.ResourcePlanet, Action: importerModel.ActionCreated, Name: "Planet 2"},
+					{Resource: importerModel.ResourceFilmPlanet, Action: importerModel.ActionLinked, Name: "Planet 2 - Film 1"},
+				},
 			},
 			expectedErr: nil,
 		},
-		"should save films that not exists": {
-			inputFilms:    []string{"1", "2"},
-			inputPlanetID: 5,
-			prepareMock: func(filmStore *mockStoreFilm.MockStore) {
-				filmStore.EXPECT().GetFilms(gomock.Any(), gomock.Any()).Return([]filmModel.ResultFilm{
-					{
-						Title: "Film 1",
-					},
-					{
-						Title: "Film 2",
+		"should skip the planets in the trash and keep their films": {
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore, peopleStore *mockStorePeople.MockStore) {
+				planetStore.EXPECT().GetPlanetsPage(gomock.Any(), 1).Return(&planetModel.ResultPlanet{
+					Count: 1,
+					Results: []planetModel.Planet{
+						{
+							Name:  "Alderaan",
+							Films: []string{"film/1"},
+						},
 					},
 				}, nil)
-				filmStore.EXPECT().GetOne(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, nil)
+				filmStore.EXPECT().GetFilm(gomock.Any(), "film/1").Return(&filmModel.ResultFilm{
+					Title: "Film 1",
+				}, nil)
+				deletedAt := edited
+				planetStore.EXPECT().GetOne(gomock.Any(), "Alderaan").Return(nil, nil)
+				planetStore.EXPECT().GetDeletedByName(gomock.Any(), "Alderaan").Return(&planetModel.PlanetDB{
+					ID:        1,
+					Name:      "Alderaan",
+					DeletedAt: &deletedAt,
+				}, nil)
 				var filmID int64 = 1
-				filmStore.EXPECT().SaveFilm(gomock.Any(), gomock.Any()).AnyTimes().Return(&filmID, nil)
-				filmStore.EXPECT().GetFilmWithPlanet(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil, nil)
-				var filmPlanetID int64 = 1
-				filmStore.EXPECT().SaveFilmWithPlanet(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(&filmPlanetID, nil)
+				filmStore.EXPECT().GetOne(gomock.Any(), "Film 1").Return(nil, nil)
+				filmStore.EXPECT().SaveFilm(gomock.Any(), gomock.Any()).Return(&filmID, nil)
+			},
+			expectedReport: &importerModel.Report{
+				Planets: importerModel.Counts{Skipped: 1},
+				Films:   importerModel.Counts{Created: 1},
+				Changes: []importerModel.Change{
+					{Resource: importerModel.ResourcePlanet, Action: importerModel.ActionSkipped, Name: "Alderaan"},
+					{Resource: importerModel.ResourceFilm, Action: importerModel.ActionCreated, Name: "Film 1"},
+				},
 			},
 			expectedErr: nil,
 		},
-		"should save films but not save films and planets": {
-			inputFilms:    []string{"1", "2"},
-			inputPlanetID: 5,
-			prepareMock: func(filmStore *mockStoreFilm.MockStore) {
-				filmStore.EXPECT().GetFilms(gomock.Any(), gomock.Any()).Return([]filmModel.ResultFilm{
-					{
-						Title: "Film 1",
-					},
-					{
-						Title: "Film 2",
+		"should throw error when update planet": {
+			incremental: true,
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore, peopleStore *mockStorePeople.MockStore) {
+				planetStore.EXPECT().GetPlanetsPage(gomock.Any(), 1).Return(&planetModel.ResultPlanet{
+					Count: 1,
+					Results: []planetModel.Planet{
+						{
+							Name:   "Planet 1",
+							Edited: edited,
+						},
 					},
 				}, nil)
-				filmStore.EXPECT().GetOne(gomock.Any(), gomock.Any()).AnyTimes().Return(&filmModel.Film{
-					ID: 1,
-				}, nil)
-				filmStore.EXPECT().GetFilmWithPlanet(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(&filmModel.FilmPlanet{
-					FilmID:   1,
-					PlanetID: 1,
+				planetStore.EXPECT().GetOne(gomock.Any(), "Planet 1").Return(&planetModel.PlanetDB{
+					ID:       1,
+					EditedAt: &before,
 				}, nil)
+				planetStore.EXPECT().UpdatePlanet(gomock.Any(), int64(1), gomock.Any()).Return(fmt.Errorf("error"))
 			},
-			expectedErr: nil,
+			expectedErr: fmt.Errorf("error"),
 		},
-		"should throw error when save film": {
-			inputFilms:    []string{"1", "2"},
-			inputPlanetID: 5,
-			prepareMock: func(filmStore *mockStoreFilm.MockStore) {
-				filmStore.EXPECT().GetFilms(gomock.Any(), gomock.Any()).Return([]filmModel.ResultFilm{
-					{
-						Title: "Film 1",
-					},
-					{
-						Title: "Film 2",
-					},
-				}, nil)
-				filmStore.EXPECT().GetOne(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, nil)
-				filmStore.EXPECT().SaveFilm(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, fmt.Errorf("error"))
+		"should throw error when get planets": {
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore, peopleStore *mockStorePeople.MockStore) {
+				planetStore.EXPECT().GetPlanetsPage(gomock.Any(), 1).Return(nil, fmt.Errorf("error"))
+			},
+			expectedErr: fmt.Errorf("error"),
+		},
+		"should throw error when get the next pages": {
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore, peopleStore *mockStorePeople.MockStore) {
+				planetStore.EXPECT().GetPlanetsPage(gomock.Any(), 1).Return(pageOne, nil)
+				planetStore.EXPECT().GetPlanetsPage(gomock.Any(), 2).Return(nil, fmt.Errorf("error"))
 			},
 			expectedErr: fmt.Errorf("error"),
 		},
+		"should abort when the api keeps rate limiting the pages": {
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore, peopleStore *mockStorePeople.MockStore) {
+				planetStore.EXPECT().GetPlanetsPage(gomock.Any(), 1).Return(pageOne, nil)
+				planetStore.EXPECT().GetPlanetsPage(gomock.Any(), 2).Times(upstreamAttempts).Return(nil, rateLimitedErr)
+			},
+			expectedErr: rateLimitedErr,
+		},
+		"should abort when the api returns a malformed body": {
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore, peopleStore *mockStorePeople.MockStore) {
+				planetStore.EXPECT().GetPlanetsPage(gomock.Any(), 1).Times(1).Return(nil, malformedErr)
+			},
+			expectedErr: malformedErr,
+		},
 		"should throw error when get films": {
-			inputFilms:    []string{"1", "2"},
-			inputPlanetID: 5,
-			prepareMock: func(filmStore *mockStoreFilm.MockStore) {
-				filmStore.EXPECT().GetFilms(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore, peopleStore *mockStorePeople.MockStore) {
+				planetStore.EXPECT().GetPlanetsPage(gomock.Any(), 1).Return(pageOne, nil)
+				planetStore.EXPECT().GetPlanetsPage(gomock.Any(), 2).Return(pageTwo, nil)
+				filmStore.EXPECT().GetFilm(gomock.Any(), gomock.Any()).MinTimes(1).Return(nil, fmt.Errorf("error"))
 			},
 			expectedErr: fmt.Errorf("error"),
 		},
 		"should throw error when get one": {
-			inputFilms:    []string{"1", "2"},
-			inputPlanetID: 5,
-			prepareMock: func(filmStore *mockStoreFilm.MockStore) {
-				filmStore.EXPECT().GetFilms(gomock.Any(), gomock.Any()).Return([]filmModel.ResultFilm{
-					{
-						Title: "Film 1",
-					},
-					{
-						Title: "Film 2",
-					},
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore, peopleStore *mockStorePeople.MockStore) {
+				planetStore.EXPECT().GetPlanetsPage(gomock.Any(), 1).Return(pageOne, nil)
+				planetStore.EXPECT().GetPlanetsPage(gomock.Any(), 2).Return(pageTwo, nil)
+				filmStore.EXPECT().GetFilm(gomock.Any(), gomock.Any()).AnyTimes().Return(&filmModel.ResultFilm{
+					Title: "Film 1",
 				}, nil)
-				filmStore.EXPECT().GetOne(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, fmt.Errorf("error"))
+				planetStore.EXPECT().GetOne(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, fmt.Errorf("error"))
 			},
 			expectedErr: fmt.Errorf("error"),
 		},
-		"should throw error when get film with planet": {
-			inputFilms:    []string{"1", "2"},
-			inputPlanetID: 5,
-			prepareMock: func(filmStore *mockStoreFilm.MockStore) {
-				filmStore.EXPECT().GetFilms(gomock.Any(), gomock.Any()).Return([]filmModel.ResultFilm{
-					{
-						Title: "Film 1",
-					},
-					{
-						Title: "Film 2",
-					},
-				}, nil)
-				filmStore.EXPECT().GetOne(gomock.Any(), gomock.Any()).AnyTimes().Return(&filmModel.Film{
-					ID: 1,
+		"should throw error when save planet": {
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore, peopleStore *mockStorePeople.MockStore) {
+				planetStore.EXPECT().GetPlanetsPage(gomock.Any(), 1).Return(pageOne, nil)
+				planetStore.EXPECT().GetPlanetsPage(gomock.Any(), 2).Return(pageTwo, nil)
+				filmStore.EXPECT().GetFilm(gomock.Any(), gomock.Any()).AnyTimes().Return(&filmModel.ResultFilm{
+					Title: "Film 1",
 				}, nil)
-				filmStore.EXPECT().GetFilmWithPlanet(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil, fmt.Errorf("error"))
+				planetStore.EXPECT().GetOne(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, nil)
+				planetStore.EXPECT().GetDeletedByName(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, nil)
+				planetStore.EXPECT().SavePlanet(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, fmt.Errorf("error"))
 			},
 			expectedErr: fmt.Errorf("error"),
 		},
-		"should throw error when save film with planet": {
-			inputFilms:    []string{"1", "2"},
-			inputPlanetID: 5,
-			prepareMock: func(filmStore *mockStoreFilm.MockStore) {
-				filmStore.EXPECT().GetFilms(gomock.Any(), gomock.Any()).Return([]filmModel.ResultFilm{
-					{
-						Title: "Film 1",
-					},
-					{
-						Title: "Film 2",
-					},
+		"should throw error when save films": {
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore, peopleStore *mockStorePeople.MockStore) {
+				planetStore.EXPECT().GetPlanetsPage(gomock.Any(), 1).Return(pageOne, nil)
+				planetStore.EXPECT().GetPlanetsPage(gomock.Any(), 2).Return(pageTwo, nil)
+				filmStore.EXPECT().GetFilm(gomock.Any(), gomock.Any()).AnyTimes().Return(&filmModel.ResultFilm{
+					Title: "Film 1",
 				}, nil)
-				filmStore.EXPECT().GetOne(gomock.Any(), gomock.Any()).AnyTimes().Return(&filmModel.Film{
-					ID: 1,
+				planetStore.EXPECT().GetOne(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, nil)
+				planetStore.EXPECT().GetDeletedByName(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, nil)
+				var planetID int64 = 1
+				planetStore.EXPECT().SavePlanet(gomock.Any(), gomock.Any()).AnyTimes().Return(&planetID, nil)
+				filmStore.EXPECT().GetOne(gomock.Any(), gomock.Any()).AnyTimes().Return(nil, fmt.Errorf("error"))
+			},
+			expectedErr: fmt.Errorf("error"),
+		},
+		"should throw error when begin the transaction": {
+			transactionErr: fmt.Errorf("error"),
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore, peopleStore *mockStorePeople.MockStore) {
+				planetStore.EXPECT().GetPlanetsPage(gomock.Any(), 1).Return(pageOne, nil)
+				planetStore.EXPECT().GetPlanetsPage(gomock.Any(), 2).Return(pageTwo, nil)
+				filmStore.EXPECT().GetFilm(gomock.Any(), gomock.Any()).Times(2).Return(&filmModel.ResultFilm{
+					Title: "Film 1",
 				}, nil)
-				filmStore.EXPECT().GetFilmWithPlanet(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil, nil)
-				filmStore.EXPECT().SaveFilmWithPlanet(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(nil, fmt.Errorf("error"))
 			},
 			expectedErr: fmt.Errorf("error"),
 		},
+		"should stop when context is canceled": {
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore, peopleStore *mockStorePeople.MockStore) {
+				planetStore.EXPECT().GetPlanetsPage(gomock.Any(), 1).Return(pageOne, nil)
+				planetStore.EXPECT().GetPlanetsPage(gomock.Any(), 2).Return(pageTwo, nil)
+				filmStore.EXPECT().GetFilm(gomock.Any(), gomock.Any()).MinTimes(1).Return(nil, context.Canceled)
+			},
+			expectedErr: context.Canceled,
+		},
 	}
 
 	for name, cs := range cases {
@@ -355,21 +585,92 @@ func TestSaveFilms(t *testing.T) {
 			defer ctrl.Finish()
 
 			filmStoreMock := mockStoreFilm.NewMockStore(ctrl)
+			planetStoreMock := mockStorePlanet.NewMockStore(ctrl)
+			peopleStoreMock := mockStorePeople.NewMockStore(ctrl)
+			starshipStoreMock := mockStoreStarship.NewMockStore(ctrl)
+			vehicleStoreMock := mockStoreVehicle.NewMockStore(ctrl)
+			speciesStoreMock := mockStoreSpecies.NewMockStore(ctrl)
+			transactionStoreMock := mockStoreTransaction.NewMockStore(ctrl)
+
+			cs.prepareMock(planetStoreMock, filmStoreMock, peopleStoreMock)
+			if cs.prepareCatalog != nil {
+				cs.prepareCatalog(starshipStoreMock, vehicleStoreMock, speciesStoreMock)
+			}
+			if cs.transactionErr != nil {
+				transactionStoreMock.EXPECT().Run(gomock.Any(), gomock.Any()).Return(cs.transactionErr)
+			} else if !cs.dryRun {
+				transactionStoreMock.EXPECT().Run(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(func(ctx context.Context, fn func(tx *sql.Tx) error) error {
+					return fn(nil)
+				})
+			}
+			planetStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(planetStoreMock)
+			filmStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(filmStoreMock)
+			peopleStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(peopleStoreMock)
+			starshipStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(starshipStoreMock)
+			vehicleStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(vehicleStoreMock)
+			speciesStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(speciesStoreMock)
 
-			cs.prepareMock(filmStoreMock)
 			app := NewApp(&store.Container{
-				Film: filmStoreMock,
+				Film:        filmStoreMock,
+				Planet:      planetStoreMock,
+				People:      peopleStoreMock,
+				Starship:    starshipStoreMock,
+				Vehicle:     vehicleStoreMock,
+				Species:     speciesStoreMock,
+				Transaction: transactionStoreMock,
 			})
 
 			// when
-			err := app.SaveFilms(ctx, cs.inputFilms, cs.inputPlanetID)
+			report, err := app.CreatePlanetsAndFilms(ctx, importerModel.Options{
+				Workers:     2,
+				Incremental: cs.incremental,
+				DryRun:      cs.dryRun,
+			})
 
 			// then
 			assert.Equal(t, cs.expectedErr, err)
+			assert.Equal(t, cs.expectedReport, report)
 		})
 	}
 }
 
+func TestRunPool(t *testing.T) {
+	t.Run("should run every job", func(t *testing.T) {
+		results := make([]int, 20)
+		err := runPool(context.Background(), 3, len(results), func(ctx context.Context, idx int) error {
+			results[idx] = idx * 2
+			return nil
+		})
+
+		assert.Equal(t, nil, err)
+		for idx, result := range results {
+			assert.Equal(t, idx*2, result)
+		}
+	})
+
+	t.Run("should stop on the first error", func(t *testing.T) {
+		err := runPool(context.Background(), 2, 100, func(ctx context.Context, idx int) error {
+			if idx == 3 {
+				return fmt.Errorf("error")
+			}
+			return nil
+		})
+
+		assert.Equal(t, fmt.Errorf("error"), err)
+	})
+
+	t.Run("should stop when context is canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := runPool(ctx, 2, 100, func(ctx context.Context, idx int) error {
+			return nil
+		})
+
+		assert.Equal(t, context.Canceled, err)
+	})
+}
+
 func TestGetAllPlanets(t *testing.T) {
 	dateString := "2021-11-22"
 	date, _ := time.Parse("2006-01-02", dateString)
@@ -400,18 +701,18 @@ func TestGetAllPlanets(t *testing.T) {
 	}
 	cases := map[string]struct {
 		inputPage       int64
-		inputOffset     int64
-		inputName       string
+		inputLimit      int64
+		inputFilter     planetModel.Filter
 		prepareMock     func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore)
 		expectedPlanets []*planetModel.PlanetDB
 		expectedErr     error
 	}{
 		"should get all planets": {
-			inputPage:   0,
-			inputOffset: 5,
-			inputName:   "Planet 1",
+			inputPage:   2,
+			inputLimit:  5,
+			inputFilter: planetModel.Filter{Name: "Planet 1"},
 			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
-				planetStore.EXPECT().GetAll(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*planetModel.PlanetDB{
+				planetStore.EXPECT().GetAll(gomock.Any(), int64(5), int64(5), planetModel.Filter{Name: "Planet 1"}).Return([]*planetModel.PlanetDB{
 					{
 						ID:        1,
 						Name:      "Planet 1",
@@ -451,9 +752,9 @@ func TestGetAllPlanets(t *testing.T) {
 			expectedErr:     nil,
 		},
 		"should return empty planets": {
-			inputPage:   0,
-			inputOffset: 5,
-			inputName:   "Planet 1",
+			inputPage:   1,
+			inputLimit:  5,
+			inputFilter: planetModel.Filter{Name: "Planet 1"},
 			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
 				planetStore.EXPECT().GetAll(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
 			},
@@ -461,9 +762,9 @@ func TestGetAllPlanets(t *testing.T) {
 			expectedErr:     planetModel.ErrorPlanetNotFound,
 		},
 		"should throw error when get all planets": {
-			inputPage:   0,
-			inputOffset: 5,
-			inputName:   "Planet 1",
+			inputPage:   1,
+			inputLimit:  5,
+			inputFilter: planetModel.Filter{Name: "Planet 1"},
 			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
 				planetStore.EXPECT().GetAll(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
 			},
@@ -471,9 +772,9 @@ func TestGetAllPlanets(t *testing.T) {
 			expectedErr:     fmt.Errorf("error"),
 		},
 		"should throw error when get films by planet ids": {
-			inputPage:   0,
-			inputOffset: 5,
-			inputName:   "Planet 1",
+			inputPage:   1,
+			inputLimit:  5,
+			inputFilter: planetModel.Filter{Name: "Planet 1"},
 			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
 				planetStore.EXPECT().GetAll(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*planetModel.PlanetDB{
 					{
@@ -507,7 +808,7 @@ func TestGetAllPlanets(t *testing.T) {
 			})
 
 			// when
-			planets, err := app.GetAllPlanets(ctx, cs.inputPage, cs.inputOffset, cs.inputName)
+			planets, err := app.GetAllPlanets(ctx, cs.inputPage, cs.inputLimit, cs.inputFilter)
 
 			// then
 			assert.Equal(t, cs.expectedErr, err)
@@ -516,14 +817,128 @@ func TestGetAllPlanets(t *testing.T) {
 	}
 }
 
-func TestDelete(t *testing.T) {
+func TestGetPlanetsByCursor(t *testing.T) {
+	cursor := &genericModel.Cursor{ID: 1}
+	backwardCursor := &genericModel.Cursor{ID: 4, Backward: true}
+	nextCursor := genericModel.Cursor{ID: 3}.Encode()
+	prevCursor := genericModel.Cursor{ID: 2, Backward: true}.Encode()
 	cases := map[string]struct {
-		inputPlanet int64
-		prepareMock func(planetStore *mockStorePlanet.MockStore)
-		expectedErr error
+		inputCursor        *genericModel.Cursor
+		prepareMock        func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore)
+		expectedPlanets    []*planetModel.PlanetDB
+		expectedPagination *genericModel.CursorPagination
+		expectedErr        error
 	}{
-		"should delete a planet by id": {
-			inputPlanet: 1,
+		"should get the planets after the cursor with their films": {
+			inputCursor: cursor,
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
+				planetStore.EXPECT().GetAllByCursor(gomock.Any(), cursor, int64(3), planetModel.Filter{}).Return([]*planetModel.PlanetDB{
+					{ID: 2, Name: "Alderaan"},
+					{ID: 3, Name: "Yavin IV"},
+					{ID: 4, Name: "Hoth"},
+				}, nil)
+				filmStore.EXPECT().GetFilmsByPlanetIDs(gomock.Any(), []int64{2, 3}).Return([]filmModel.FilmPlanet{
+					{FilmID: 1, PlanetID: 2, Film: filmModel.Film{ID: 1, Name: "A New Hope"}},
+				}, nil)
+			},
+			expectedPlanets: []*planetModel.PlanetDB{
+				{ID: 2, Name: "Alderaan", Films: []filmModel.Film{{ID: 1, Name: "A New Hope"}}},
+				{ID: 3, Name: "Yavin IV"},
+			},
+			expectedPagination: &genericModel.CursorPagination{Limit: 2, NextCursor: &nextCursor, PrevCursor: &prevCursor},
+			expectedErr:        nil,
+		},
+		"should get the planets before a backward cursor": {
+			inputCursor: backwardCursor,
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
+				planetStore.EXPECT().GetAllByCursor(gomock.Any(), backwardCursor, int64(3), planetModel.Filter{}).Return([]*planetModel.PlanetDB{
+					{ID: 1, Name: "Tatooine"},
+					{ID: 2, Name: "Alderaan"},
+					{ID: 3, Name: "Yavin IV"},
+				}, nil)
+				filmStore.EXPECT().GetFilmsByPlanetIDs(gomock.Any(), []int64{2, 3}).Return(nil, nil)
+			},
+			expectedPlanets: []*planetModel.PlanetDB{
+				{ID: 2, Name: "Alderaan"},
+				{ID: 3, Name: "Yavin IV"},
+			},
+			expectedPagination: &genericModel.CursorPagination{Limit: 2, NextCursor: &nextCursor, PrevCursor: &prevCursor},
+			expectedErr:        nil,
+		},
+		"should return planet not found past the last planet": {
+			inputCursor: cursor,
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
+				planetStore.EXPECT().GetAllByCursor(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			expectedPlanets:    nil,
+			expectedPagination: nil,
+			expectedErr:        planetModel.ErrorPlanetNotFound,
+		},
+		"should throw error with a cursor of another sort": {
+			inputCursor: &genericModel.Cursor{Sort: "name", Keys: []string{"Alderaan"}, ID: 2},
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
+			},
+			expectedPlanets:    nil,
+			expectedPagination: nil,
+			expectedErr:        genericModel.ErrorInvalidCursor,
+		},
+		"should throw error when get planets by cursor": {
+			inputCursor: cursor,
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
+				planetStore.EXPECT().GetAllByCursor(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			expectedPlanets:    nil,
+			expectedPagination: nil,
+			expectedErr:        fmt.Errorf("error"),
+		},
+		"should throw error when get films by planet ids": {
+			inputCursor: cursor,
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
+				planetStore.EXPECT().GetAllByCursor(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*planetModel.PlanetDB{
+					{ID: 2, Name: "Alderaan"},
+				}, nil)
+				filmStore.EXPECT().GetFilmsByPlanetIDs(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			expectedPlanets:    nil,
+			expectedPagination: nil,
+			expectedErr:        fmt.Errorf("error"),
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			// given
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			defer ctrl.Finish()
+
+			planetStoreMock := mockStorePlanet.NewMockStore(ctrl)
+			filmStoreMock := mockStoreFilm.NewMockStore(ctrl)
+
+			cs.prepareMock(planetStoreMock, filmStoreMock)
+			app := NewApp(&store.Container{
+				Planet: planetStoreMock,
+				Film:   filmStoreMock,
+			})
+
+			// when
+			planets, pagination, err := app.GetPlanetsByCursor(ctx, cs.inputCursor, 2, planetModel.Filter{})
+
+			// then
+			assert.Equal(t, cs.expectedErr, err)
+			assert.Equal(t, cs.expectedPlanets, planets)
+			assert.Equal(t, cs.expectedPagination, pagination)
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		inputPlanet int64
+		prepareMock func(planetStore *mockStorePlanet.MockStore)
+		expectedErr error
+	}{
+		"should delete a planet by id": {
+			inputPlanet: 1,
 			prepareMock: func(planetStore *mockStorePlanet.MockStore) {
 				planetStore.EXPECT().GetOneByID(gomock.Any(), gomock.Any()).Return(&planetModel.PlanetDB{
 					ID: 1,
@@ -699,6 +1114,7 @@ func TestGetOneByID(t *testing.T) {
 
 func TestGetTotalPlanets(t *testing.T) {
 	var total int64 = 1
+	filter := planetModel.Filter{Name: "Planet 1"}
 	cases := map[string]struct {
 		prepareMock   func(planetStore *mockStorePlanet.MockStore)
 		expectedTotal *int64
@@ -706,14 +1122,14 @@ func TestGetTotalPlanets(t *testing.T) {
 	}{
 		"should return a total of planets": {
 			prepareMock: func(planetStore *mockStorePlanet.MockStore) {
-				planetStore.EXPECT().GetTotalPlanets(gomock.Any()).Return(&total, nil)
+				planetStore.EXPECT().GetTotalPlanets(gomock.Any(), filter).Return(&total, nil)
 			},
 			expectedTotal: &total,
 			expectedErr:   nil,
 		},
 		"should throw error when get a total": {
 			prepareMock: func(planetStore *mockStorePlanet.MockStore) {
-				planetStore.EXPECT().GetTotalPlanets(gomock.Any()).Return(nil, fmt.Errorf("error"))
+				planetStore.EXPECT().GetTotalPlanets(gomock.Any(), filter).Return(nil, fmt.Errorf("error"))
 			},
 			expectedTotal: nil,
 			expectedErr:   fmt.Errorf("error"),
@@ -734,7 +1150,7 @@ func TestGetTotalPlanets(t *testing.T) {
 			})
 
 			// when
-			planetDB, err := app.GetTotalPlanets(ctx)
+			planetDB, err := app.GetTotalPlanets(ctx, filter)
 
 			// then
 			assert.Equal(t, cs.expectedErr, err)
@@ -743,3 +1159,662 @@ func TestGetTotalPlanets(t *testing.T) {
 		})
 	}
 }
+
+func TestGetFilmsByPlanetID(t *testing.T) {
+	dateString := "2021-11-22"
+	date, _ := time.Parse("2006-01-02", dateString)
+	filmsExpected := []*filmModel.Film{
+		{
+			ID:          1,
+			Name:        "A New Hope",
+			Director:    "George Lucas",
+			ReleaseDate: date,
+			CreatedAt:   date,
+		},
+	}
+
+	cases := map[string]struct {
+		inputPlanet   int64
+		prepareMock   func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore)
+		expectedFilms []*filmModel.Film
+		expectedErr   error
+	}{
+		"should return the films of a planet": {
+			inputPlanet: 1,
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
+				planetStore.EXPECT().GetOneByID(gomock.Any(), int64(1)).Return(&planetModel.PlanetDB{
+					ID: 1,
+				}, nil)
+				filmStore.EXPECT().GetAllByPlanetID(gomock.Any(), int64(1), int64(0), int64(10)).Return([]*filmModel.Film{
+					{
+						ID:          1,
+						Name:        "A New Hope",
+						Director:    "George Lucas",
+						ReleaseDate: date,
+						CreatedAt:   date,
+					},
+				}, nil)
+			},
+			expectedFilms: filmsExpected,
+			expectedErr:   nil,
+		},
+		"should return planet not found": {
+			inputPlanet: 1,
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
+				planetStore.EXPECT().GetOneByID(gomock.Any(), gomock.Any()).Return(nil, planetModel.ErrorPlanetNotFound)
+			},
+			expectedFilms: nil,
+			expectedErr:   planetModel.ErrorPlanetNotFound,
+		},
+		"should return film not found when the planet has no films": {
+			inputPlanet: 1,
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
+				planetStore.EXPECT().GetOneByID(gomock.Any(), gomock.Any()).Return(&planetModel.PlanetDB{
+					ID: 1,
+				}, nil)
+				filmStore.EXPECT().GetAllByPlanetID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			expectedFilms: nil,
+			expectedErr:   filmModel.ErrorFilmNotFound,
+		},
+		"should throw error when get the films by planet id": {
+			inputPlanet: 1,
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
+				planetStore.EXPECT().GetOneByID(gomock.Any(), gomock.Any()).Return(&planetModel.PlanetDB{
+					ID: 1,
+				}, nil)
+				filmStore.EXPECT().GetAllByPlanetID(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			expectedFilms: nil,
+			expectedErr:   fmt.Errorf("error"),
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			// given
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			defer ctrl.Finish()
+
+			planetStoreMock := mockStorePlanet.NewMockStore(ctrl)
+			filmStoreMock := mockStoreFilm.NewMockStore(ctrl)
+
+			cs.prepareMock(planetStoreMock, filmStoreMock)
+			app := NewApp(&store.Container{
+				Planet: planetStoreMock,
+				Film:   filmStoreMock,
+			})
+
+			// when
+			films, err := app.GetFilmsByPlanetID(ctx, cs.inputPlanet, 1, 10)
+
+			// then
+			assert.Equal(t, cs.expectedErr, err)
+			assert.Equal(t, cs.expectedFilms, films)
+		})
+	}
+}
+
+func TestCreatePlanet(t *testing.T) {
+	var planetID int64 = 61
+	var population int64 = 1000
+	planetExpected := planetModel.PlanetDB{
+		ID:         61,
+		Name:       "Ilum",
+		Climate:    "frozen",
+		Terrain:    "glaciers",
+		Population: &population,
+		Films: []filmModel.Film{
+			{
+				ID:   1,
+				Name: "Film 1",
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		inputPlanet    planetModel.PlanetRequest
+		prepareMock    func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore)
+		expectedPlanet *planetModel.PlanetDB
+		expectedErr    error
+	}{
+		"should create a planet linked to the films": {
+			inputPlanet: planetModel.PlanetRequest{
+				Name:       "Ilum",
+				Climate:    "frozen",
+				Terrain:    "glaciers",
+				Population: &population,
+				FilmIDs:    []int64{1, 1},
+			},
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
+				planetStore.EXPECT().CreatePlanet(gomock.Any(), gomock.Any()).Return(&planetID, nil)
+				filmStore.EXPECT().GetOneByID(gomock.Any(), int64(1)).Return(&filmModel.Film{ID: 1}, nil)
+				filmStore.EXPECT().SaveFilmWithPlanet(gomock.Any(), planetID, int64(1)).Return(nil, nil)
+				planetStore.EXPECT().GetOneByID(gomock.Any(), planetID).Return(&planetModel.PlanetDB{
+					ID:         61,
+					Name:       "Ilum",
+					Climate:    "frozen",
+					Terrain:    "glaciers",
+					Population: &population,
+				}, nil)
+				filmStore.EXPECT().GetFilmsByPlanetIDs(gomock.Any(), []int64{planetID}).Return([]filmModel.FilmPlanet{
+					{
+						FilmID:   1,
+						PlanetID: planetID,
+						Film: filmModel.Film{
+							ID:   1,
+							Name: "Film 1",
+						},
+					},
+				}, nil)
+			},
+			expectedPlanet: &planetExpected,
+			expectedErr:    nil,
+		},
+		"should return planet already exists": {
+			inputPlanet: planetModel.PlanetRequest{
+				Name:    "Tatooine",
+				Climate: "arid",
+				Terrain: "desert",
+			},
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
+				planetStore.EXPECT().CreatePlanet(gomock.Any(), gomock.Any()).Return(nil, planetModel.ErrorPlanetAlreadyExists)
+			},
+			expectedPlanet: nil,
+			expectedErr:    planetModel.ErrorPlanetAlreadyExists,
+		},
+		"should return film not found": {
+			inputPlanet: planetModel.PlanetRequest{
+				Name:    "Ilum",
+				Climate: "frozen",
+				Terrain: "glaciers",
+				FilmIDs: []int64{99},
+			},
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
+				planetStore.EXPECT().CreatePlanet(gomock.Any(), gomock.Any()).Return(&planetID, nil)
+				filmStore.EXPECT().GetOneByID(gomock.Any(), int64(99)).Return(nil, filmModel.ErrorFilmNotFound)
+			},
+			expectedPlanet: nil,
+			expectedErr:    filmModel.ErrorFilmNotFound,
+		},
+		"should throw error when link the films": {
+			inputPlanet: planetModel.PlanetRequest{
+				Name:    "Ilum",
+				Climate: "frozen",
+				Terrain: "glaciers",
+				FilmIDs: []int64{1},
+			},
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
+				planetStore.EXPECT().CreatePlanet(gomock.Any(), gomock.Any()).Return(&planetID, nil)
+				filmStore.EXPECT().GetOneByID(gomock.Any(), int64(1)).Return(&filmModel.Film{ID: 1}, nil)
+				filmStore.EXPECT().SaveFilmWithPlanet(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			expectedPlanet: nil,
+			expectedErr:    fmt.Errorf("error"),
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			// given
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			defer ctrl.Finish()
+
+			planetStoreMock := mockStorePlanet.NewMockStore(ctrl)
+			filmStoreMock := mockStoreFilm.NewMockStore(ctrl)
+			peopleStoreMock := mockStorePeople.NewMockStore(ctrl)
+			starshipStoreMock := mockStoreStarship.NewMockStore(ctrl)
+			vehicleStoreMock := mockStoreVehicle.NewMockStore(ctrl)
+			speciesStoreMock := mockStoreSpecies.NewMockStore(ctrl)
+			transactionStoreMock := mockStoreTransaction.NewMockStore(ctrl)
+
+			cs.prepareMock(planetStoreMock, filmStoreMock)
+			transactionStoreMock.EXPECT().Run(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(tx *sql.Tx) error) error {
+				return fn(nil)
+			})
+			planetStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(planetStoreMock)
+			filmStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(filmStoreMock)
+			peopleStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(peopleStoreMock)
+			starshipStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(starshipStoreMock)
+			vehicleStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(vehicleStoreMock)
+			speciesStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(speciesStoreMock)
+
+			app := NewApp(&store.Container{
+				Planet:      planetStoreMock,
+				Film:        filmStoreMock,
+				People:      peopleStoreMock,
+				Starship:    starshipStoreMock,
+				Vehicle:     vehicleStoreMock,
+				Species:     speciesStoreMock,
+				Transaction: transactionStoreMock,
+			})
+
+			// when
+			planet, err := app.CreatePlanet(ctx, cs.inputPlanet)
+
+			// then
+			assert.Equal(t, cs.expectedErr, err)
+			assert.Equal(t, cs.expectedPlanet, planet)
+		})
+	}
+}
+
+func TestUpdatePlanet(t *testing.T) {
+	var planetID int64 = 1
+	planetExpected := planetModel.PlanetDB{
+		ID:      1,
+		Name:    "Tatooine",
+		Climate: "arid",
+		Terrain: "desert",
+	}
+
+	cases := map[string]struct {
+		inputPlanet    planetModel.PlanetRequest
+		prepareMock    func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore)
+		expectedPlanet *planetModel.PlanetDB
+		expectedErr    error
+	}{
+		"should replace the planet and its films": {
+			inputPlanet: planetModel.PlanetRequest{
+				Name:    "Tatooine",
+				Climate: "arid",
+				Terrain: "desert",
+				FilmIDs: []int64{1},
+			},
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
+				planetStore.EXPECT().GetOneByID(gomock.Any(), planetID).Return(&planetModel.PlanetDB{
+					ID:      1,
+					Name:    "Tatooine",
+					Climate: "arid",
+					Terrain: "desert",
+				}, nil)
+				planetStore.EXPECT().ReplacePlanet(gomock.Any(), planetID, planetModel.PlanetRequest{
+					Name:    "Tatooine",
+					Climate: "arid",
+					Terrain: "desert",
+					FilmIDs: []int64{1},
+				}).Return(nil)
+				filmStore.EXPECT().DeleteFilmsWithPlanet(gomock.Any(), planetID).Return(nil)
+				filmStore.EXPECT().GetOneByID(gomock.Any(), int64(1)).Return(&filmModel.Film{ID: 1}, nil)
+				filmStore.EXPECT().SaveFilmWithPlanet(gomock.Any(), planetID, int64(1)).Return(nil, nil)
+				filmStore.EXPECT().GetFilmsByPlanetIDs(gomock.Any(), []int64{planetID}).Return(nil, nil)
+			},
+			expectedPlanet: &planetExpected,
+			expectedErr:    nil,
+		},
+		"should return planet not found for a deleted planet": {
+			inputPlanet: planetModel.PlanetRequest{
+				Name:    "Tatooine",
+				Climate: "arid",
+				Terrain: "desert",
+			},
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
+				planetStore.EXPECT().ReplacePlanet(gomock.Any(), gomock.Any(), gomock.Any()).Return(planetModel.ErrorPlanetNotFound)
+			},
+			expectedPlanet: nil,
+			expectedErr:    planetModel.ErrorPlanetNotFound,
+		},
+		"should return planet already exists": {
+			inputPlanet: planetModel.PlanetRequest{
+				Name:    "Alderaan",
+				Climate: "arid",
+				Terrain: "desert",
+			},
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
+				planetStore.EXPECT().ReplacePlanet(gomock.Any(), gomock.Any(), gomock.Any()).Return(planetModel.ErrorPlanetAlreadyExists)
+			},
+			expectedPlanet: nil,
+			expectedErr:    planetModel.ErrorPlanetAlreadyExists,
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			// given
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			defer ctrl.Finish()
+
+			planetStoreMock := mockStorePlanet.NewMockStore(ctrl)
+			filmStoreMock := mockStoreFilm.NewMockStore(ctrl)
+			peopleStoreMock := mockStorePeople.NewMockStore(ctrl)
+			starshipStoreMock := mockStoreStarship.NewMockStore(ctrl)
+			vehicleStoreMock := mockStoreVehicle.NewMockStore(ctrl)
+			speciesStoreMock := mockStoreSpecies.NewMockStore(ctrl)
+			transactionStoreMock := mockStoreTransaction.NewMockStore(ctrl)
+
+			cs.prepareMock(planetStoreMock, filmStoreMock)
+			transactionStoreMock.EXPECT().Run(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(tx *sql.Tx) error) error {
+				return fn(nil)
+			})
+			planetStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(planetStoreMock)
+			filmStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(filmStoreMock)
+			peopleStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(peopleStoreMock)
+			starshipStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(starshipStoreMock)
+			vehicleStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(vehicleStoreMock)
+			speciesStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(speciesStoreMock)
+
+			app := NewApp(&store.Container{
+				Planet:      planetStoreMock,
+				Film:        filmStoreMock,
+				People:      peopleStoreMock,
+				Starship:    starshipStoreMock,
+				Vehicle:     vehicleStoreMock,
+				Species:     speciesStoreMock,
+				Transaction: transactionStoreMock,
+			})
+
+			// when
+			planet, err := app.UpdatePlanet(ctx, planetID, cs.inputPlanet)
+
+			// then
+			assert.Equal(t, cs.expectedErr, err)
+			assert.Equal(t, cs.expectedPlanet, planet)
+		})
+	}
+}
+
+func TestPatchPlanet(t *testing.T) {
+	var planetID int64 = 1
+	var population int64 = 200000
+	current := planetModel.PlanetDB{
+		ID:         1,
+		Name:       "Tatooine",
+		Climate:    "arid",
+		Terrain:    "desert",
+		Population: &population,
+	}
+
+	cases := map[string]struct {
+		inputPatch     string
+		prepareMock    func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore)
+		expectedPlanet *planetModel.PlanetDB
+		expectedErr    error
+	}{
+		"should update only the fields of the patch and keep the films": {
+			inputPatch: `{"climate": "temperate", "population": null}`,
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
+				gomock.InOrder(
+					planetStore.EXPECT().GetOneByID(gomock.Any(), planetID).Return(&current, nil),
+					planetStore.EXPECT().ReplacePlanet(gomock.Any(), planetID, planetModel.PlanetRequest{
+						Name:    "Tatooine",
+						Climate: "temperate",
+						Terrain: "desert",
+					}).Return(nil),
+					planetStore.EXPECT().GetOneByID(gomock.Any(), planetID).Return(&planetModel.PlanetDB{
+						ID:      1,
+						Name:    "Tatooine",
+						Climate: "temperate",
+						Terrain: "desert",
+					}, nil),
+				)
+				filmStore.EXPECT().GetFilmsByPlanetIDs(gomock.Any(), []int64{planetID}).Return(nil, nil)
+			},
+			expectedPlanet: &planetModel.PlanetDB{
+				ID:      1,
+				Name:    "Tatooine",
+				Climate: "temperate",
+				Terrain: "desert",
+			},
+			expectedErr: nil,
+		},
+		"should replace the films": {
+			inputPatch: `{"film_ids": [2]}`,
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
+				planetStore.EXPECT().GetOneByID(gomock.Any(), planetID).Times(2).Return(&current, nil)
+				planetStore.EXPECT().ReplacePlanet(gomock.Any(), planetID, gomock.Any()).Return(nil)
+				filmStore.EXPECT().DeleteFilmsWithPlanet(gomock.Any(), planetID).Return(nil)
+				filmStore.EXPECT().GetOneByID(gomock.Any(), int64(2)).Return(&filmModel.Film{ID: 2}, nil)
+				filmStore.EXPECT().SaveFilmWithPlanet(gomock.Any(), planetID, int64(2)).Return(nil, nil)
+				filmStore.EXPECT().GetFilmsByPlanetIDs(gomock.Any(), []int64{planetID}).Return(nil, nil)
+			},
+			expectedPlanet: &current,
+			expectedErr:    nil,
+		},
+		"should throw error with an invalid field": {
+			inputPatch: `{"terrain": null}`,
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
+				planetStore.EXPECT().GetOneByID(gomock.Any(), planetID).Return(&current, nil)
+			},
+			expectedPlanet: nil,
+			expectedErr:    &planetModel.InvalidFieldError{Field: "terrain"},
+		},
+		"should return planet not found for a deleted planet": {
+			inputPatch: `{"climate": "temperate"}`,
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
+				planetStore.EXPECT().GetOneByID(gomock.Any(), planetID).Return(nil, planetModel.ErrorPlanetNotFound)
+			},
+			expectedPlanet: nil,
+			expectedErr:    planetModel.ErrorPlanetNotFound,
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			// given
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			defer ctrl.Finish()
+
+			planetStoreMock := mockStorePlanet.NewMockStore(ctrl)
+			filmStoreMock := mockStoreFilm.NewMockStore(ctrl)
+			peopleStoreMock := mockStorePeople.NewMockStore(ctrl)
+			starshipStoreMock := mockStoreStarship.NewMockStore(ctrl)
+			vehicleStoreMock := mockStoreVehicle.NewMockStore(ctrl)
+			speciesStoreMock := mockStoreSpecies.NewMockStore(ctrl)
+			transactionStoreMock := mockStoreTransaction.NewMockStore(ctrl)
+
+			cs.prepareMock(planetStoreMock, filmStoreMock)
+			transactionStoreMock.EXPECT().Run(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(tx *sql.Tx) error) error {
+				return fn(nil)
+			})
+			planetStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(planetStoreMock)
+			filmStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(filmStoreMock)
+			peopleStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(peopleStoreMock)
+			starshipStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(starshipStoreMock)
+			vehicleStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(vehicleStoreMock)
+			speciesStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(speciesStoreMock)
+
+			app := NewApp(&store.Container{
+				Planet:      planetStoreMock,
+				Film:        filmStoreMock,
+				People:      peopleStoreMock,
+				Starship:    starshipStoreMock,
+				Vehicle:     vehicleStoreMock,
+				Species:     speciesStoreMock,
+				Transaction: transactionStoreMock,
+			})
+
+			var patch planetModel.PlanetPatch
+			_ = json.Unmarshal([]byte(cs.inputPatch), &patch)
+
+			// when
+			planet, err := app.PatchPlanet(ctx, planetID, patch)
+
+			// then
+			assert.Equal(t, cs.expectedErr, err)
+			assert.Equal(t, cs.expectedPlanet, planet)
+		})
+	}
+}
+
+func TestGetDeletedPlanets(t *testing.T) {
+	dateString := "2021-11-22"
+	date, _ := time.Parse("2006-01-02", dateString)
+	planetsExpected := []*planetModel.PlanetDB{
+		{
+			ID:        1,
+			Name:      "Alderaan",
+			DeletedAt: &date,
+			Films: []filmModel.Film{
+				{
+					ID:   1,
+					Name: "Film 1",
+				},
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		prepareMock     func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore)
+		expectedPlanets []*planetModel.PlanetDB
+		expectedErr     error
+	}{
+		"should get the deleted planets with their films": {
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
+				planetStore.EXPECT().GetAllDeleted(gomock.Any(), int64(0), int64(5)).Return([]*planetModel.PlanetDB{
+					{
+						ID:        1,
+						Name:      "Alderaan",
+						DeletedAt: &date,
+					},
+				}, nil)
+				filmStore.EXPECT().GetFilmsByPlanetIDs(gomock.Any(), []int64{1}).Return([]filmModel.FilmPlanet{
+					{
+						FilmID:    1,
+						PlanetID:  1,
+						DeletedAt: &date,
+						Film: filmModel.Film{
+							ID:   1,
+							Name: "Film 1",
+						},
+					},
+				}, nil)
+			},
+			expectedPlanets: planetsExpected,
+			expectedErr:     nil,
+		},
+		"should return empty planets": {
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
+				planetStore.EXPECT().GetAllDeleted(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+			},
+			expectedPlanets: nil,
+			expectedErr:     planetModel.ErrorPlanetNotFound,
+		},
+		"should throw error when get the deleted planets": {
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
+				planetStore.EXPECT().GetAllDeleted(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			expectedPlanets: nil,
+			expectedErr:     fmt.Errorf("error"),
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			// given
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			defer ctrl.Finish()
+
+			planetStoreMock := mockStorePlanet.NewMockStore(ctrl)
+			filmStoreMock := mockStoreFilm.NewMockStore(ctrl)
+
+			cs.prepareMock(planetStoreMock, filmStoreMock)
+			app := NewApp(&store.Container{
+				Planet: planetStoreMock,
+				Film:   filmStoreMock,
+			})
+
+			// when
+			planets, err := app.GetDeletedPlanets(ctx, 1, 5)
+
+			// then
+			assert.Equal(t, cs.expectedErr, err)
+			assert.Equal(t, cs.expectedPlanets, planets)
+		})
+	}
+}
+
+func TestRestore(t *testing.T) {
+	var planetID int64 = 1
+	dateString := "2021-11-22"
+	date, _ := time.Parse("2006-01-02", dateString)
+
+	cases := map[string]struct {
+		prepareMock    func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore)
+		expectedPlanet *planetModel.PlanetDB
+		expectedErr    error
+	}{
+		"should restore a deleted planet": {
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
+				planetStore.EXPECT().GetDeletedByID(gomock.Any(), planetID).Return(&planetModel.PlanetDB{
+					ID:        1,
+					Name:      "Alderaan",
+					DeletedAt: &date,
+				}, nil)
+				planetStore.EXPECT().Restore(gomock.Any(), planetID).Return(nil)
+				planetStore.EXPECT().GetOneByID(gomock.Any(), planetID).Return(&planetModel.PlanetDB{
+					ID:   1,
+					Name: "Alderaan",
+				}, nil)
+				filmStore.EXPECT().GetFilmsByPlanetIDs(gomock.Any(), []int64{planetID}).Return(nil, nil)
+			},
+			expectedPlanet: &planetModel.PlanetDB{
+				ID:   1,
+				Name: "Alderaan",
+			},
+			expectedErr: nil,
+		},
+		"should return planet not found when it is not deleted": {
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
+				planetStore.EXPECT().GetDeletedByID(gomock.Any(), planetID).Return(nil, planetModel.ErrorPlanetNotFound)
+			},
+			expectedPlanet: nil,
+			expectedErr:    planetModel.ErrorPlanetNotFound,
+		},
+		"should return planet already exists when the name was taken": {
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore) {
+				planetStore.EXPECT().GetDeletedByID(gomock.Any(), planetID).Return(&planetModel.PlanetDB{
+					ID:        1,
+					Name:      "Alderaan",
+					DeletedAt: &date,
+				}, nil)
+				planetStore.EXPECT().Restore(gomock.Any(), planetID).Return(planetModel.ErrorPlanetAlreadyExists)
+			},
+			expectedPlanet: nil,
+			expectedErr:    planetModel.ErrorPlanetAlreadyExists,
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			// given
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			defer ctrl.Finish()
+
+			planetStoreMock := mockStorePlanet.NewMockStore(ctrl)
+			filmStoreMock := mockStoreFilm.NewMockStore(ctrl)
+			peopleStoreMock := mockStorePeople.NewMockStore(ctrl)
+			starshipStoreMock := mockStoreStarship.NewMockStore(ctrl)
+			vehicleStoreMock := mockStoreVehicle.NewMockStore(ctrl)
+			speciesStoreMock := mockStoreSpecies.NewMockStore(ctrl)
+			transactionStoreMock := mockStoreTransaction.NewMockStore(ctrl)
+
+			cs.prepareMock(planetStoreMock, filmStoreMock)
+			transactionStoreMock.EXPECT().Run(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(tx *sql.Tx) error) error {
+				return fn(nil)
+			})
+			planetStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(planetStoreMock)
+			filmStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(filmStoreMock)
+			peopleStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(peopleStoreMock)
+			starshipStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(starshipStoreMock)
+			vehicleStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(vehicleStoreMock)
+			speciesStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(speciesStoreMock)
+
+			app := NewApp(&store.Container{
+				Planet:      planetStoreMock,
+				Film:        filmStoreMock,
+				People:      peopleStoreMock,
+				Starship:    starshipStoreMock,
+				Vehicle:     vehicleStoreMock,
+				Species:     speciesStoreMock,
+				Transaction: transactionStoreMock,
+			})
+
+			// when
+			planet, err := app.Restore(ctx, planetID)
+
+			// then
+			assert.Equal(t, cs.expectedErr, err)
+			assert.Equal(t, cs.expectedPlanet, planet)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package planet
+
+import (
+	"context"
+	"sync"
+)
+
+// runPool calls job for every index in [0, total) using at most workers goroutines.
+// The first error cancels the jobs still running and is returned..
+func runPool(ctx context.Context, workers, total int, job func(ctx context.Context, idx int) error) error {
+	if workers < 1 {
+		workers = 1
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	jobs := make(chan int)
+	errs := make(chan error, 1)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range jobs {
+				if err := job(ctx, idx); err != nil {
+					select {
+					case errs <- err:
+					default:
+					}
+					cancel()
+					return
+				}
+			}
+		}()
+	}
+
+feed:
+	for idx := 0; idx < total; idx++ {
+		select {
+		case jobs <- idx:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	select {
+	case err := <-errs:
+		return err
+	default:
+		return ctx.Err()
+	}
+}
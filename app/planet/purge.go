@@ -0,0 +1,60 @@
+package planet
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	planetModel "github.com/danilotadeu/star_wars/model/planet"
+	"github.com/sirupsen/logrus"
+)
+
+// Purge hard delete the planets soft deleted longer than retention ago, with their film links.
+// People born on those planets are kept without a homeworld, so the foreign keys never block the delete..
+func (a *appImpl) Purge(ctx context.Context, retention time.Duration) (*planetModel.PurgeReport, error) {
+	deletedBefore := time.Now().Add(-retention)
+
+	var report planetModel.PurgeReport
+	err := a.store.Transaction.Run(ctx, func(tx *sql.Tx) error {
+		stores := a.store.WithTx(tx)
+
+		filmLinks, err := stores.Film.PurgeFilmsWithPlanet(ctx, deletedBefore)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "app.planet.Purge.Store.Film.PurgeFilmsWithPlanet"}).Error(err)
+			return err
+		}
+
+		homeworlds, err := stores.People.DetachDeletedHomeworld(ctx, deletedBefore)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "app.planet.Purge.Store.People.DetachDeletedHomeworld"}).Error(err)
+			return err
+		}
+
+		planets, err := stores.Planet.Purge(ctx, deletedBefore)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "app.planet.Purge.Store.Planet.Purge"}).Error(err)
+			return err
+		}
+
+		report = planetModel.PurgeReport{
+			Planets:    planets,
+			FilmLinks:  filmLinks,
+			Homeworlds: homeworlds,
+		}
+		return nil
+	})
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.planet.Purge.Store.Transaction.Run"}).Error(err)
+		return nil, err
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"trace":      "app.planet.Purge",
+		"retention":  retention.String(),
+		"planets":    report.Planets,
+		"film_links": report.FilmLinks,
+		"homeworlds": report.Homeworlds,
+	}).Info("Purged soft deleted planets")
+
+	return &report, nil
+}
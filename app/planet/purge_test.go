@@ -0,0 +1,129 @@
+package planet
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	mockStoreFilm "github.com/danilotadeu/star_wars/mock/store/film"
+	mockStorePeople "github.com/danilotadeu/star_wars/mock/store/people"
+	mockStorePlanet "github.com/danilotadeu/star_wars/mock/store/planet"
+	mockStoreSpecies "github.com/danilotadeu/star_wars/mock/store/species"
+	mockStoreStarship "github.com/danilotadeu/star_wars/mock/store/starship"
+	mockStoreTransaction "github.com/danilotadeu/star_wars/mock/store/transaction"
+	mockStoreVehicle "github.com/danilotadeu/star_wars/mock/store/vehicle"
+	planetModel "github.com/danilotadeu/star_wars/model/planet"
+	"github.com/danilotadeu/star_wars/store"
+	"github.com/golang/mock/gomock"
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestPurge(t *testing.T) {
+	retention := 720 * time.Hour
+	errPurge := fmt.Errorf("error")
+
+	cases := map[string]struct {
+		prepareMock    func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore, peopleStore *mockStorePeople.MockStore)
+		expectedReport *planetModel.PurgeReport
+		expectedErr    error
+	}{
+		"should purge film links, homeworlds and planets deleted before the retention": {
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore, peopleStore *mockStorePeople.MockStore) {
+				var cutoff time.Time
+				gomock.InOrder(
+					filmStore.EXPECT().PurgeFilmsWithPlanet(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, deletedBefore time.Time) (int64, error) {
+						if time.Since(deletedBefore) < retention {
+							t.Errorf("deletedBefore %s is inside the retention", deletedBefore)
+						}
+						cutoff = deletedBefore
+						return 5, nil
+					}),
+					peopleStore.EXPECT().DetachDeletedHomeworld(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, deletedBefore time.Time) (int64, error) {
+						assert.Equal(t, cutoff, deletedBefore)
+						return 1, nil
+					}),
+					planetStore.EXPECT().Purge(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, deletedBefore time.Time) (int64, error) {
+						assert.Equal(t, cutoff, deletedBefore)
+						return 2, nil
+					}),
+				)
+			},
+			expectedReport: &planetModel.PurgeReport{
+				Planets:    2,
+				FilmLinks:  5,
+				Homeworlds: 1,
+			},
+			expectedErr: nil,
+		},
+		"should throw error when purge film links": {
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore, peopleStore *mockStorePeople.MockStore) {
+				filmStore.EXPECT().PurgeFilmsWithPlanet(gomock.Any(), gomock.Any()).Return(int64(0), errPurge)
+			},
+			expectedReport: nil,
+			expectedErr:    errPurge,
+		},
+		"should throw error when detach homeworlds": {
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore, peopleStore *mockStorePeople.MockStore) {
+				filmStore.EXPECT().PurgeFilmsWithPlanet(gomock.Any(), gomock.Any()).Return(int64(5), nil)
+				peopleStore.EXPECT().DetachDeletedHomeworld(gomock.Any(), gomock.Any()).Return(int64(0), errPurge)
+			},
+			expectedReport: nil,
+			expectedErr:    errPurge,
+		},
+		"should throw error when purge planets": {
+			prepareMock: func(planetStore *mockStorePlanet.MockStore, filmStore *mockStoreFilm.MockStore, peopleStore *mockStorePeople.MockStore) {
+				filmStore.EXPECT().PurgeFilmsWithPlanet(gomock.Any(), gomock.Any()).Return(int64(5), nil)
+				peopleStore.EXPECT().DetachDeletedHomeworld(gomock.Any(), gomock.Any()).Return(int64(1), nil)
+				planetStore.EXPECT().Purge(gomock.Any(), gomock.Any()).Return(int64(0), errPurge)
+			},
+			expectedReport: nil,
+			expectedErr:    errPurge,
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			// given
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			defer ctrl.Finish()
+
+			planetStoreMock := mockStorePlanet.NewMockStore(ctrl)
+			filmStoreMock := mockStoreFilm.NewMockStore(ctrl)
+			peopleStoreMock := mockStorePeople.NewMockStore(ctrl)
+			starshipStoreMock := mockStoreStarship.NewMockStore(ctrl)
+			vehicleStoreMock := mockStoreVehicle.NewMockStore(ctrl)
+			speciesStoreMock := mockStoreSpecies.NewMockStore(ctrl)
+			transactionStoreMock := mockStoreTransaction.NewMockStore(ctrl)
+
+			cs.prepareMock(planetStoreMock, filmStoreMock, peopleStoreMock)
+			transactionStoreMock.EXPECT().Run(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, fn func(tx *sql.Tx) error) error {
+				return fn(nil)
+			})
+			planetStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(planetStoreMock)
+			filmStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(filmStoreMock)
+			peopleStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(peopleStoreMock)
+			starshipStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(starshipStoreMock)
+			vehicleStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(vehicleStoreMock)
+			speciesStoreMock.EXPECT().WithTx(gomock.Any()).AnyTimes().Return(speciesStoreMock)
+
+			app := NewApp(&store.Container{
+				Planet:      planetStoreMock,
+				Film:        filmStoreMock,
+				People:      peopleStoreMock,
+				Starship:    starshipStoreMock,
+				Vehicle:     vehicleStoreMock,
+				Species:     speciesStoreMock,
+				Transaction: transactionStoreMock,
+			})
+
+			// when
+			report, err := app.Purge(ctx, retention)
+
+			// then
+			assert.Equal(t, cs.expectedErr, err)
+			assert.Equal(t, cs.expectedReport, report)
+		})
+	}
+}
@@ -0,0 +1,189 @@
+package planet
+
+import (
+	"context"
+	"time"
+
+	importerModel "github.com/danilotadeu/star_wars/model/importer"
+	"github.com/sirupsen/logrus"
+)
+
+// registry keeps the resources of a single import keyed by their SWAPI url,
+// so every one is requested and saved only once no matter how many planets or films list it..
+type registry[R any] struct {
+	resource importerModel.Resource
+	options  importerModel.Options
+	report   *importerModel.Report
+	get      func(ctx context.Context, url string) (*R, error)
+	describe func(result R) (name string, edited time.Time)
+	urls     []string
+	entries  map[string]*registered[R]
+}
+
+type registered[R any] struct {
+	result *R
+	id     *int64
+	synced bool
+}
+
+// newRegistry create the registry of resource, requested with get and found on the database and recorded on report
+// by the name returned by describe, along with its SWAPI edited timestamp..
+func newRegistry[R any](resource importerModel.Resource, get func(ctx context.Context, url string) (*R, error), describe func(result R) (string, time.Time), options importerModel.Options, report *importerModel.Report) *registry[R] {
+	return &registry[R]{
+		resource: resource,
+		options:  options,
+		report:   report,
+		get:      get,
+		describe: describe,
+		entries:  map[string]*registered[R]{},
+	}
+}
+
+// add register the urls not seen yet, keeping the order they first appear..
+func (r *registry[R]) add(urls ...string) {
+	for _, url := range urls {
+		if _, ok := r.entries[url]; ok {
+			continue
+		}
+
+		r.entries[url] = &registered[R]{}
+		r.urls = append(r.urls, url)
+	}
+}
+
+// lookup return the resource of url fetched from the api, nil when it was not returned,
+// and its database id once synced..
+func (r *registry[R]) lookup(url string) (*R, *int64) {
+	registered, ok := r.entries[url]
+	if !ok {
+		return nil, nil
+	}
+
+	return registered.result, registered.id
+}
+
+// fetch get every registered resource from the api using a pool of workers, the ones missing on SWAPI are skipped..
+func (r *registry[R]) fetch(ctx context.Context) error {
+	return runPool(ctx, r.options.Workers, len(r.urls), func(ctx context.Context, idx int) error {
+		url := r.urls[idx]
+		_, err := callUpstream(ctx, url, func(ctx context.Context) (err error) {
+			r.entries[url].result, err = r.get(ctx, url)
+			return err
+		})
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "app.planet.registry.fetch.get", "resource": r.resource}).Error(err)
+			return err
+		}
+
+		return nil
+	})
+}
+
+// name return the name of a resource returned by the api..
+func (r *registry[R]) name(result R) string {
+	name, _ := r.describe(result)
+	return name
+}
+
+// sync save the resource with upsert on store on the first call and return it with its database id.
+// The resource is nil when the api did not return it, the id is nil for the ones not saved on a dry run..
+func (r *registry[R]) sync(ctx context.Context, url string, store resourceStore[R]) (*R, *int64, error) {
+	registered, ok := r.entries[url]
+	if !ok || registered.result == nil {
+		return nil, nil, nil
+	}
+
+	if registered.synced {
+		return registered.result, registered.id, nil
+	}
+
+	name, edited := r.describe(*registered.result)
+	id, action, err := upsert(ctx, r.options, store, *registered.result, name, edited)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.planet.registry.sync.upsert", "resource": r.resource}).Error(err)
+		return nil, nil, err
+	}
+
+	r.report.Record(r.resource, action, name)
+	registered.id = id
+	registered.synced = true
+	return registered.result, id, nil
+}
+
+// saved is a resource found on the database by upsert, deleted when it is in the trash..
+type saved struct {
+	id       int64
+	editedAt *time.Time
+	deleted  bool
+}
+
+// upsert return the id of the resource found by name on store or save a new one.
+// On incremental imports an existing resource edited on SWAPI after the last import is updated,
+// on a dry run nothing is written and the id of a new resource is nil. A deleted resource is skipped, without id..
+func upsert[R any](ctx context.Context, options importerModel.Options, store resourceStore[R], result R, name string, edited time.Time) (*int64, importerModel.Action, error) {
+	exists, err := store.find(ctx, name)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.planet.upsert.find"}).Error(err)
+		return nil, "", err
+	}
+
+	if exists == nil {
+		if options.DryRun {
+			return nil, importerModel.ActionCreated, nil
+		}
+
+		id, err := store.save(ctx, result)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "app.planet.upsert.save"}).Error(err)
+			return nil, "", err
+		}
+
+		return id, importerModel.ActionCreated, nil
+	}
+
+	if exists.deleted {
+		return nil, importerModel.ActionSkipped, nil
+	}
+
+	if !options.Incremental || !editedSince(edited, exists.editedAt) {
+		return &exists.id, importerModel.ActionUnchanged, nil
+	}
+
+	if !options.DryRun {
+		err = store.update(ctx, exists.id, result)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "app.planet.upsert.update"}).Error(err)
+			return nil, "", err
+		}
+	}
+
+	return &exists.id, importerModel.ActionUpdated, nil
+}
+
+// link save the relation between a resource and a film when store has not linked them yet, reporting if it was new.
+// On a dry run nothing is written and a nil id means the resource or the film would be created..
+func link[R any](ctx context.Context, store resourceStore[R], id, filmID *int64, dryRun bool) (bool, error) {
+	if id == nil || filmID == nil {
+		return true, nil
+	}
+
+	found, err := store.linked(ctx, *id, *filmID)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "app.planet.link.linked"}).Error(err)
+		return false, err
+	}
+
+	if found {
+		return false, nil
+	}
+
+	if !dryRun {
+		err = store.link(ctx, *id, *filmID)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "app.planet.link.link"}).Error(err)
+			return false, err
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,141 @@
+package planet
+
+import (
+	"context"
+	"time"
+
+	filmModel "github.com/danilotadeu/star_wars/model/film"
+	importerModel "github.com/danilotadeu/star_wars/model/importer"
+	peopleModel "github.com/danilotadeu/star_wars/model/people"
+	planetModel "github.com/danilotadeu/star_wars/model/planet"
+	resourceModel "github.com/danilotadeu/star_wars/model/resource"
+	speciesModel "github.com/danilotadeu/star_wars/model/species"
+	starshipModel "github.com/danilotadeu/star_wars/model/starship"
+	vehicleModel "github.com/danilotadeu/star_wars/model/vehicle"
+	"github.com/danilotadeu/star_wars/store"
+	storeResource "github.com/danilotadeu/star_wars/store/resource"
+)
+
+// importRegistries are the registries of the resources listed by the planets and the films of an import..
+type importRegistries struct {
+	films     *registry[filmModel.ResultFilm]
+	people    *registry[peopleModel.ResultPeople]
+	starships *registry[starshipModel.ResultStarship]
+	vehicles  *registry[vehicleModel.ResultVehicle]
+	species   *registry[speciesModel.ResultSpecies]
+}
+
+func newImportRegistries(stores *store.Container, options importerModel.Options, report *importerModel.Report) importRegistries {
+	return importRegistries{
+		films: newRegistry(importerModel.ResourceFilm, stores.Film.GetFilm,
+			func(film filmModel.ResultFilm) (string, time.Time) { return film.Title, film.Edited }, options, report),
+		people: newRegistry(importerModel.ResourcePeople, stores.People.GetPeople,
+			func(people peopleModel.ResultPeople) (string, time.Time) { return people.Name, people.Edited }, options, report),
+		starships: newRegistry(importerModel.ResourceStarship, stores.Starship.Get,
+			func(starship starshipModel.ResultStarship) (string, time.Time) { return starship.Name, starship.Edited }, options, report),
+		vehicles: newRegistry(importerModel.ResourceVehicle, stores.Vehicle.Get,
+			func(vehicle vehicleModel.ResultVehicle) (string, time.Time) { return vehicle.Name, vehicle.Edited }, options, report),
+		species: newRegistry(importerModel.ResourceSpecies, stores.Species.Get,
+			func(species speciesModel.ResultSpecies) (string, time.Time) { return species.Name, species.Edited }, options, report),
+	}
+}
+
+// resourceStore are the queries used by upsert and link to save a resource R and its relation with a film..
+type resourceStore[R any] struct {
+	find   func(ctx context.Context, name string) (*saved, error)
+	save   func(ctx context.Context, result R) (*int64, error)
+	update func(ctx context.Context, id int64, result R) error
+	linked func(ctx context.Context, id, filmID int64) (bool, error)
+	link   func(ctx context.Context, id, filmID int64) error
+}
+
+// planetStore save the planets, linked to the films by the film store.
+// A planet without an active row but in the trash is found as deleted, so the import does not bring it back
+// as a duplicate of the one that can still be restored..
+func planetStore(stores *store.Container) resourceStore[planetModel.Planet] {
+	return resourceStore[planetModel.Planet]{
+		find: func(ctx context.Context, name string) (*saved, error) {
+			exists, err := stores.Planet.GetOne(ctx, name)
+			if err != nil {
+				return nil, err
+			}
+			if exists == nil {
+				exists, err = stores.Planet.GetDeletedByName(ctx, name)
+				if exists == nil || err != nil {
+					return nil, err
+				}
+			}
+			return &saved{id: exists.ID, editedAt: exists.EditedAt, deleted: exists.DeletedAt != nil}, nil
+		},
+		save:   stores.Planet.SavePlanet,
+		update: stores.Planet.UpdatePlanet,
+		linked: func(ctx context.Context, planetID, filmID int64) (bool, error) {
+			filmPlanet, err := stores.Film.GetFilmWithPlanet(ctx, planetID, filmID)
+			return filmPlanet != nil, err
+		},
+		link: func(ctx context.Context, planetID, filmID int64) error {
+			_, err := stores.Film.SaveFilmWithPlanet(ctx, planetID, filmID)
+			return err
+		},
+	}
+}
+
+// filmStore save the films found by their title..
+func filmStore(stores *store.Container) resourceStore[filmModel.ResultFilm] {
+	return resourceStore[filmModel.ResultFilm]{
+		find: func(ctx context.Context, title string) (*saved, error) {
+			exists, err := stores.Film.GetOne(ctx, title)
+			if exists == nil || err != nil {
+				return nil, err
+			}
+			return &saved{id: exists.ID, editedAt: exists.EditedAt}, nil
+		},
+		save:   stores.Film.SaveFilm,
+		update: stores.Film.UpdateFilm,
+	}
+}
+
+// peopleStore save the people born on the planet of planetIDs keyed by the SWAPI url of their homeworld..
+func peopleStore(stores *store.Container, planetIDs map[string]*int64) resourceStore[peopleModel.ResultPeople] {
+	return resourceStore[peopleModel.ResultPeople]{
+		find: func(ctx context.Context, name string) (*saved, error) {
+			exists, err := stores.People.GetOne(ctx, name)
+			if exists == nil || err != nil {
+				return nil, err
+			}
+			return &saved{id: exists.ID, editedAt: exists.EditedAt}, nil
+		},
+		save: func(ctx context.Context, people peopleModel.ResultPeople) (*int64, error) {
+			return stores.People.SavePeople(ctx, people, planetIDs[people.Homeworld])
+		},
+		update: func(ctx context.Context, id int64, people peopleModel.ResultPeople) error {
+			return stores.People.UpdatePeople(ctx, id, people, planetIDs[people.Homeworld])
+		},
+		linked: func(ctx context.Context, peopleID, filmID int64) (bool, error) {
+			filmPeople, err := stores.People.GetFilmWithPeople(ctx, peopleID, filmID)
+			return filmPeople != nil, err
+		},
+		link: stores.People.SaveFilmWithPeople,
+	}
+}
+
+// catalogStore save the starships, vehicles or species of the shared resource store..
+func catalogStore[R any, D resourceModel.Record](queries storeResource.Queries[R, D]) resourceStore[R] {
+	return resourceStore[R]{
+		find: func(ctx context.Context, name string) (*saved, error) {
+			var none D
+			exists, err := queries.GetOne(ctx, name)
+			if err != nil || any(exists) == any(none) {
+				return nil, err
+			}
+			return &saved{id: exists.RecordID(), editedAt: exists.RecordEdited()}, nil
+		},
+		save:   queries.Save,
+		update: queries.Update,
+		linked: func(ctx context.Context, id, filmID int64) (bool, error) {
+			film, err := queries.GetFilm(ctx, id, filmID)
+			return film != nil, err
+		},
+		link: queries.SaveFilm,
+	}
+}
@@ -0,0 +1,85 @@
+package planet
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	swapiModel "github.com/danilotadeu/star_wars/model/swapi"
+	"github.com/sirupsen/logrus"
+)
+
+type upstreamDecision int
+
+const (
+	upstreamAbort upstreamDecision = iota
+	upstreamSkip
+	upstreamRetry
+)
+
+// upstreamAttempts is how many times callUpstream run a call that SWAPI keeps rate limiting or failing,
+// waiting the Retry-After of the response or upstreamRetryDelay between them..
+var (
+	upstreamAttempts   = 3
+	upstreamRetryDelay = time.Second
+)
+
+// decideUpstream choose what the import does with an error of SWAPI: a missing resource is skipped,
+// rate limits and server errors that outlasted the retries of the transport are tried again
+// and a malformed body, like everything else, aborts the import..
+func decideUpstream(err error) upstreamDecision {
+	switch {
+	case errors.Is(err, swapiModel.ErrorNotFound):
+		return upstreamSkip
+	case errors.Is(err, swapiModel.ErrorRateLimited), errors.Is(err, swapiModel.ErrorServer):
+		return upstreamRetry
+	case errors.Is(err, swapiModel.ErrorMalformedBody):
+		return upstreamAbort
+	}
+
+	return upstreamAbort
+}
+
+// callUpstream run call applying decideUpstream, reporting skipped when the resource does not exist on SWAPI.
+// Retries stop after upstreamAttempts or when ctx is done, returning the last error of SWAPI..
+func callUpstream(ctx context.Context, url string, call func(ctx context.Context) error) (skipped bool, err error) {
+	for attempt := 1; ; attempt++ {
+		err = call(ctx)
+		if err == nil {
+			return false, nil
+		}
+
+		switch decideUpstream(err) {
+		case upstreamSkip:
+			logrus.WithFields(logrus.Fields{"trace": "app.planet.callUpstream.skip", "url": url}).Warn(err)
+			return true, nil
+		case upstreamAbort:
+			return false, err
+		}
+
+		if attempt >= upstreamAttempts {
+			return false, err
+		}
+
+		delay := retryDelay(err)
+		logrus.WithFields(logrus.Fields{"trace": "app.planet.callUpstream.retry", "url": url, "attempt": attempt, "delay": delay.String()}).Warn(err)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return false, err
+		case <-timer.C:
+		}
+	}
+}
+
+// retryDelay return the Retry-After sent by SWAPI with err, otherwise upstreamRetryDelay..
+func retryDelay(err error) time.Duration {
+	var upstreamErr *swapiModel.UpstreamError
+	if errors.As(err, &upstreamErr) && upstreamErr.RetryAfter > 0 {
+		return upstreamErr.RetryAfter
+	}
+
+	return upstreamRetryDelay
+}
@@ -0,0 +1,122 @@
+package planet
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	swapiModel "github.com/danilotadeu/star_wars/model/swapi"
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestCallUpstream(t *testing.T) {
+	delay := upstreamRetryDelay
+	upstreamRetryDelay = time.Millisecond
+	defer func() { upstreamRetryDelay = delay }()
+
+	notFoundErr := &swapiModel.UpstreamError{
+		Err:        swapiModel.ErrorNotFound,
+		StatusCode: 404,
+	}
+	rateLimitedErr := &swapiModel.UpstreamError{
+		Err:        swapiModel.ErrorRateLimited,
+		StatusCode: 429,
+		RetryAfter: time.Millisecond,
+	}
+	serverErr := &swapiModel.UpstreamError{
+		Err:        swapiModel.ErrorServer,
+		StatusCode: 503,
+	}
+	malformedErr := &swapiModel.UpstreamError{
+		Err:        swapiModel.ErrorMalformedBody,
+		StatusCode: 200,
+	}
+
+	cases := map[string]struct {
+		errs             []error
+		cancel           bool
+		expectedDecision upstreamDecision
+		expectedCalls    int
+		expectedSkipped  bool
+		expectedErr      error
+	}{
+		"should retry a rate limited call until it succeeds": {
+			errs:             []error{rateLimitedErr, rateLimitedErr, nil},
+			expectedDecision: upstreamRetry,
+			expectedCalls:    3,
+			expectedSkipped:  false,
+			expectedErr:      nil,
+		},
+		"should retry a server error until it succeeds": {
+			errs:             []error{serverErr, nil},
+			expectedDecision: upstreamRetry,
+			expectedCalls:    2,
+			expectedSkipped:  false,
+			expectedErr:      nil,
+		},
+		"should abort when the retries are exhausted": {
+			errs:             []error{serverErr, serverErr, serverErr, nil},
+			expectedDecision: upstreamRetry,
+			expectedCalls:    upstreamAttempts,
+			expectedSkipped:  false,
+			expectedErr:      serverErr,
+		},
+		"should stop retrying when the context is done": {
+			errs:             []error{rateLimitedErr, nil},
+			cancel:           true,
+			expectedDecision: upstreamRetry,
+			expectedCalls:    1,
+			expectedSkipped:  false,
+			expectedErr:      rateLimitedErr,
+		},
+		"should skip a resource not found": {
+			errs:             []error{notFoundErr},
+			expectedDecision: upstreamSkip,
+			expectedCalls:    1,
+			expectedSkipped:  true,
+			expectedErr:      nil,
+		},
+		"should abort on a malformed body": {
+			errs:             []error{malformedErr},
+			expectedDecision: upstreamAbort,
+			expectedCalls:    1,
+			expectedSkipped:  false,
+			expectedErr:      malformedErr,
+		},
+		"should abort on any other error": {
+			errs:             []error{fmt.Errorf("error")},
+			expectedDecision: upstreamAbort,
+			expectedCalls:    1,
+			expectedSkipped:  false,
+			expectedErr:      fmt.Errorf("error"),
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			// given
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if cs.cancel {
+				cancel()
+			}
+
+			calls := 0
+			call := func(ctx context.Context) error {
+				err := cs.errs[calls]
+				calls++
+				return err
+			}
+
+			// when
+			skipped, err := callUpstream(ctx, "planets page 1", call)
+
+			// then
+			assert.Equal(t, cs.expectedDecision, decideUpstream(cs.errs[0]))
+			assert.Equal(t, cs.expectedCalls, calls)
+			assert.Equal(t, cs.expectedSkipped, skipped)
+			assert.Equal(t, cs.expectedErr, err)
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package resource
+
+import (
+	"context"
+
+	genericModel "github.com/danilotadeu/star_wars/model/generic"
+	resourceModel "github.com/danilotadeu/star_wars/model/resource"
+	storeResource "github.com/danilotadeu/star_wars/store/resource"
+	"github.com/sirupsen/logrus"
+)
+
+// App is a contract to the resources listed with their films, like starships..
+//
+//go:generate mockgen -destination ../../mock/app/resource/resource_app_mock.go -package mockAppResource . App
+type App interface {
+	GetOneByID(ctx context.Context, id int64) (resourceModel.Record, error)
+	GetAll(ctx context.Context, page, limit int64, name string) ([]resourceModel.Record, error)
+	GetTotal(ctx context.Context, name string) (*int64, error)
+}
+
+type appImpl[D resourceModel.Record] struct {
+	kind     string
+	store    storeResource.Reader[D]
+	notFound error
+}
+
+// NewApp init the app of the resource kind read from store, notFound is returned when nothing is found..
+func NewApp[D resourceModel.Record](kind string, store storeResource.Reader[D], notFound error) App {
+	return &appImpl[D]{
+		kind:     kind,
+		store:    store,
+		notFound: notFound,
+	}
+}
+
+func (a *appImpl[D]) trace(trace string) *logrus.Entry {
+	return logrus.WithFields(logrus.Fields{"trace": "app.resource." + trace, "resource": a.kind})
+}
+
+func (a *appImpl[D]) GetOneByID(ctx context.Context, id int64) (resourceModel.Record, error) {
+	record, err := a.store.GetOneByID(ctx, id)
+	if err != nil {
+		a.trace("GetOneByID.Store.GetOneByID").Error(err)
+		return nil, err
+	}
+
+	films, err := a.store.GetFilmsByIDs(ctx, []int64{record.RecordID()})
+	if err != nil {
+		a.trace("GetOneByID.Store.GetFilmsByIDs").Error(err)
+		return nil, err
+	}
+
+	for _, film := range films {
+		record.AddFilm(film.Film)
+	}
+
+	return record, nil
+}
+
+// GetAll list a page, starting at 1, of the resources whose name contains name..
+func (a *appImpl[D]) GetAll(ctx context.Context, page, limit int64, name string) ([]resourceModel.Record, error) {
+	records, err := a.store.GetAll(ctx, genericModel.Offset(page, limit), limit, name)
+	if err != nil {
+		a.trace("GetAll.Store.GetAll").Error(err)
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		return nil, a.notFound
+	}
+
+	ids := make([]int64, len(records))
+	for idx, record := range records {
+		ids[idx] = record.RecordID()
+	}
+
+	films, err := a.store.GetFilmsByIDs(ctx, ids)
+	if err != nil {
+		a.trace("GetAll.Store.GetFilmsByIDs").Error(err)
+		return nil, err
+	}
+
+	results := make([]resourceModel.Record, len(records))
+	for idx, record := range records {
+		for _, film := range films {
+			if record.RecordID() == film.ResourceID {
+				record.AddFilm(film.Film)
+			}
+		}
+		results[idx] = record
+	}
+
+	return results, nil
+}
+
+func (a *appImpl[D]) GetTotal(ctx context.Context, name string) (*int64, error) {
+	total, err := a.store.GetTotal(ctx, name)
+	if err != nil {
+		a.trace("GetTotal.Store.GetTotal").Error(err)
+		return nil, err
+	}
+	return total, nil
+}
@@ -0,0 +1,258 @@
+package resource
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	mockStoreStarship "github.com/danilotadeu/star_wars/mock/store/starship"
+	filmModel "github.com/danilotadeu/star_wars/model/film"
+	resourceModel "github.com/danilotadeu/star_wars/model/resource"
+	starshipModel "github.com/danilotadeu/star_wars/model/starship"
+	"github.com/golang/mock/gomock"
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestGetAll(t *testing.T) {
+	dateString := "2021-11-22"
+	date, _ := time.Parse("2006-01-02", dateString)
+	film := filmModel.Film{
+		ID:          1,
+		Name:        "Film 1",
+		Director:    "Director 1",
+		ReleaseDate: date,
+		CreatedAt:   date,
+	}
+	cases := map[string]struct {
+		inputPage       int64
+		inputLimit      int64
+		inputName       string
+		prepareMock     func(starshipStore *mockStoreStarship.MockStore)
+		expectedRecords []resourceModel.Record
+		expectedErr     error
+	}{
+		"should get all resources with their films": {
+			inputPage:  3,
+			inputLimit: 5,
+			prepareMock: func(starshipStore *mockStoreStarship.MockStore) {
+				starshipStore.EXPECT().GetAll(gomock.Any(), int64(10), int64(5), "").Return([]*starshipModel.StarshipDB{
+					{
+						ID:        1,
+						Name:      "X-wing",
+						CreatedAt: date,
+					},
+					{
+						ID:        2,
+						Name:      "TIE Advanced x1",
+						CreatedAt: date,
+					},
+				}, nil)
+				starshipStore.EXPECT().GetFilmsByIDs(gomock.Any(), []int64{1, 2}).Return([]resourceModel.FilmLink{
+					{
+						ResourceID: 1,
+						FilmID:     1,
+						CreatedAt:  date,
+						Film:       film,
+					},
+				}, nil)
+			},
+			expectedRecords: []resourceModel.Record{
+				&starshipModel.StarshipDB{
+					ID:        1,
+					Name:      "X-wing",
+					CreatedAt: date,
+					Films:     []filmModel.Film{film},
+				},
+				&starshipModel.StarshipDB{
+					ID:        2,
+					Name:      "TIE Advanced x1",
+					CreatedAt: date,
+				},
+			},
+			expectedErr: nil,
+		},
+		"should return not found when there are no resources": {
+			inputPage:  1,
+			inputLimit: 5,
+			inputName:  "X-wing",
+			prepareMock: func(starshipStore *mockStoreStarship.MockStore) {
+				starshipStore.EXPECT().GetAll(gomock.Any(), int64(0), int64(5), "X-wing").Return(nil, nil)
+			},
+			expectedRecords: nil,
+			expectedErr:     starshipModel.ErrorStarshipNotFound,
+		},
+		"should throw error when get all resources": {
+			inputPage:  1,
+			inputLimit: 5,
+			prepareMock: func(starshipStore *mockStoreStarship.MockStore) {
+				starshipStore.EXPECT().GetAll(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			expectedRecords: nil,
+			expectedErr:     fmt.Errorf("error"),
+		},
+		"should throw error when get the films by ids": {
+			inputPage:  1,
+			inputLimit: 5,
+			prepareMock: func(starshipStore *mockStoreStarship.MockStore) {
+				starshipStore.EXPECT().GetAll(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]*starshipModel.StarshipDB{
+					{
+						ID:   1,
+						Name: "X-wing",
+					},
+				}, nil)
+				starshipStore.EXPECT().GetFilmsByIDs(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			expectedRecords: nil,
+			expectedErr:     fmt.Errorf("error"),
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			// given
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			defer ctrl.Finish()
+
+			starshipStoreMock := mockStoreStarship.NewMockStore(ctrl)
+
+			cs.prepareMock(starshipStoreMock)
+			app := NewApp[*starshipModel.StarshipDB]("starship", starshipStoreMock, starshipModel.ErrorStarshipNotFound)
+
+			// when
+			records, err := app.GetAll(ctx, cs.inputPage, cs.inputLimit, cs.inputName)
+
+			// then
+			assert.Equal(t, cs.expectedErr, err)
+			assert.Equal(t, cs.expectedRecords, records)
+		})
+	}
+}
+
+func TestGetOneByID(t *testing.T) {
+	dateString := "2021-11-22"
+	date, _ := time.Parse("2006-01-02", dateString)
+	film := filmModel.Film{
+		ID:          1,
+		Name:        "Film 1",
+		Director:    "Director 1",
+		ReleaseDate: date,
+		CreatedAt:   date,
+	}
+
+	cases := map[string]struct {
+		inputID        int64
+		prepareMock    func(starshipStore *mockStoreStarship.MockStore)
+		expectedRecord resourceModel.Record
+		expectedErr    error
+	}{
+		"should return a resource with its films": {
+			inputID: 1,
+			prepareMock: func(starshipStore *mockStoreStarship.MockStore) {
+				starshipStore.EXPECT().GetOneByID(gomock.Any(), int64(1)).Return(&starshipModel.StarshipDB{
+					ID:        1,
+					Name:      "X-wing",
+					CreatedAt: date,
+				}, nil)
+				starshipStore.EXPECT().GetFilmsByIDs(gomock.Any(), []int64{1}).Return([]resourceModel.FilmLink{
+					{
+						ResourceID: 1,
+						FilmID:     1,
+						CreatedAt:  date,
+						Film:       film,
+					},
+				}, nil)
+			},
+			expectedRecord: &starshipModel.StarshipDB{
+				ID:        1,
+				Name:      "X-wing",
+				CreatedAt: date,
+				Films:     []filmModel.Film{film},
+			},
+			expectedErr: nil,
+		},
+		"should return resource not found": {
+			inputID: 1,
+			prepareMock: func(starshipStore *mockStoreStarship.MockStore) {
+				starshipStore.EXPECT().GetOneByID(gomock.Any(), gomock.Any()).Return(nil, starshipModel.ErrorStarshipNotFound)
+			},
+			expectedRecord: nil,
+			expectedErr:    starshipModel.ErrorStarshipNotFound,
+		},
+		"should throw error when get the films by id": {
+			inputID: 1,
+			prepareMock: func(starshipStore *mockStoreStarship.MockStore) {
+				starshipStore.EXPECT().GetOneByID(gomock.Any(), gomock.Any()).Return(&starshipModel.StarshipDB{
+					ID: 1,
+				}, nil)
+				starshipStore.EXPECT().GetFilmsByIDs(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("error"))
+			},
+			expectedRecord: nil,
+			expectedErr:    fmt.Errorf("error"),
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			// given
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			defer ctrl.Finish()
+
+			starshipStoreMock := mockStoreStarship.NewMockStore(ctrl)
+
+			cs.prepareMock(starshipStoreMock)
+			app := NewApp[*starshipModel.StarshipDB]("starship", starshipStoreMock, starshipModel.ErrorStarshipNotFound)
+
+			// when
+			record, err := app.GetOneByID(ctx, cs.inputID)
+
+			// then
+			assert.Equal(t, cs.expectedErr, err)
+			assert.Equal(t, cs.expectedRecord, record)
+		})
+	}
+}
+
+func TestGetTotal(t *testing.T) {
+	var total int64 = 1
+	cases := map[string]struct {
+		prepareMock   func(starshipStore *mockStoreStarship.MockStore)
+		expectedTotal *int64
+		expectedErr   error
+	}{
+		"should return a total of resources": {
+			prepareMock: func(starshipStore *mockStoreStarship.MockStore) {
+				starshipStore.EXPECT().GetTotal(gomock.Any(), "").Return(&total, nil)
+			},
+			expectedTotal: &total,
+			expectedErr:   nil,
+		},
+		"should throw error when get a total": {
+			prepareMock: func(starshipStore *mockStoreStarship.MockStore) {
+				starshipStore.EXPECT().GetTotal(gomock.Any(), "").Return(nil, fmt.Errorf("error"))
+			},
+			expectedTotal: nil,
+			expectedErr:   fmt.Errorf("error"),
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			// given
+			ctrl, ctx := gomock.WithContext(context.Background(), t)
+			defer ctrl.Finish()
+
+			starshipStoreMock := mockStoreStarship.NewMockStore(ctrl)
+
+			cs.prepareMock(starshipStoreMock)
+			app := NewApp[*starshipModel.StarshipDB]("starship", starshipStoreMock, starshipModel.ErrorStarshipNotFound)
+
+			// when
+			total, err := app.GetTotal(ctx, "")
+
+			// then
+			assert.Equal(t, cs.expectedErr, err)
+			assert.Equal(t, total, cs.expectedTotal)
+		})
+	}
+}
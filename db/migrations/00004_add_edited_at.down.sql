@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE planet DROP COLUMN edited_at;
+ALTER TABLE film DROP COLUMN edited_at;
+
+COMMIT;
\ No newline at end of file
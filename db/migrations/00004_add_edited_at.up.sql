@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE planet ADD COLUMN edited_at TIMESTAMP(6) NULL DEFAULT NULL;
+ALTER TABLE film ADD COLUMN edited_at TIMESTAMP(6) NULL DEFAULT NULL;
+
+COMMIT;
\ No newline at end of file
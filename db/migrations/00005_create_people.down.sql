@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE film_people;
+DROP TABLE people;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,39 @@
+BEGIN;
+
+CREATE TABLE people (
+  id INT NOT NULL AUTO_INCREMENT,
+  name VARCHAR(100) NOT NULL,
+  height VARCHAR(45) NOT NULL,
+  mass VARCHAR(45) NOT NULL,
+  hair_color VARCHAR(45) NOT NULL,
+  skin_color VARCHAR(45) NOT NULL,
+  eye_color VARCHAR(45) NOT NULL,
+  birth_year VARCHAR(45) NOT NULL,
+  gender VARCHAR(45) NOT NULL,
+  planet_id INT NULL DEFAULT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  edited_at TIMESTAMP(6) NULL DEFAULT NULL,
+  PRIMARY KEY (id),
+  CONSTRAINT UC_PEOPLE_NAME UNIQUE (name),
+  CONSTRAINT people_planet_fk
+    FOREIGN KEY (planet_id)
+    REFERENCES planet (id)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION);
+
+CREATE TABLE film_people (
+  people_id INT NOT NULL,
+  film_id INT NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  CONSTRAINT film_people_people_fk
+    FOREIGN KEY (people_id)
+    REFERENCES people (id)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION,
+  CONSTRAINT film_people_film_fk
+    FOREIGN KEY (film_id)
+    REFERENCES film (id)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION);
+
+COMMIT;
\ No newline at end of file
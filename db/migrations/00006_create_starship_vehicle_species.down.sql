@@ -0,0 +1,10 @@
+BEGIN;
+
+DROP TABLE film_species;
+DROP TABLE species;
+DROP TABLE film_vehicle;
+DROP TABLE vehicle;
+DROP TABLE film_starship;
+DROP TABLE starship;
+
+COMMIT;
\ No newline at end of file
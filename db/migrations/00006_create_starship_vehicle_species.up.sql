@@ -0,0 +1,102 @@
+BEGIN;
+
+CREATE TABLE starship (
+  id INT NOT NULL AUTO_INCREMENT,
+  name VARCHAR(100) NOT NULL,
+  model VARCHAR(100) NOT NULL,
+  manufacturer VARCHAR(100) NOT NULL,
+  cost_in_credits VARCHAR(100) NOT NULL,
+  length VARCHAR(100) NOT NULL,
+  max_atmosphering_speed VARCHAR(100) NOT NULL,
+  crew VARCHAR(100) NOT NULL,
+  passengers VARCHAR(100) NOT NULL,
+  cargo_capacity VARCHAR(100) NOT NULL,
+  consumables VARCHAR(100) NOT NULL,
+  hyperdrive_rating VARCHAR(100) NOT NULL,
+  mglt VARCHAR(100) NOT NULL,
+  starship_class VARCHAR(100) NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  edited_at TIMESTAMP(6) NULL DEFAULT NULL,
+  PRIMARY KEY (id),
+  CONSTRAINT UC_STARSHIP_NAME UNIQUE (name));
+
+CREATE TABLE film_starship (
+  starship_id INT NOT NULL,
+  film_id INT NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  CONSTRAINT film_starship_starship_fk
+    FOREIGN KEY (starship_id)
+    REFERENCES starship (id)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION,
+  CONSTRAINT film_starship_film_fk
+    FOREIGN KEY (film_id)
+    REFERENCES film (id)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION);
+
+CREATE TABLE vehicle (
+  id INT NOT NULL AUTO_INCREMENT,
+  name VARCHAR(100) NOT NULL,
+  model VARCHAR(100) NOT NULL,
+  manufacturer VARCHAR(100) NOT NULL,
+  cost_in_credits VARCHAR(100) NOT NULL,
+  length VARCHAR(100) NOT NULL,
+  max_atmosphering_speed VARCHAR(100) NOT NULL,
+  crew VARCHAR(100) NOT NULL,
+  passengers VARCHAR(100) NOT NULL,
+  cargo_capacity VARCHAR(100) NOT NULL,
+  consumables VARCHAR(100) NOT NULL,
+  vehicle_class VARCHAR(100) NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  edited_at TIMESTAMP(6) NULL DEFAULT NULL,
+  PRIMARY KEY (id),
+  CONSTRAINT UC_VEHICLE_NAME UNIQUE (name));
+
+CREATE TABLE film_vehicle (
+  vehicle_id INT NOT NULL,
+  film_id INT NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  CONSTRAINT film_vehicle_vehicle_fk
+    FOREIGN KEY (vehicle_id)
+    REFERENCES vehicle (id)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION,
+  CONSTRAINT film_vehicle_film_fk
+    FOREIGN KEY (film_id)
+    REFERENCES film (id)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION);
+
+CREATE TABLE species (
+  id INT NOT NULL AUTO_INCREMENT,
+  name VARCHAR(100) NOT NULL,
+  classification VARCHAR(100) NOT NULL,
+  designation VARCHAR(100) NOT NULL,
+  average_height VARCHAR(100) NOT NULL,
+  skin_colors VARCHAR(100) NOT NULL,
+  hair_colors VARCHAR(100) NOT NULL,
+  eye_colors VARCHAR(100) NOT NULL,
+  average_lifespan VARCHAR(100) NOT NULL,
+  language VARCHAR(100) NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  edited_at TIMESTAMP(6) NULL DEFAULT NULL,
+  PRIMARY KEY (id),
+  CONSTRAINT UC_SPECIES_NAME UNIQUE (name));
+
+CREATE TABLE film_species (
+  species_id INT NOT NULL,
+  film_id INT NOT NULL,
+  created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+  CONSTRAINT film_species_species_fk
+    FOREIGN KEY (species_id)
+    REFERENCES species (id)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION,
+  CONSTRAINT film_species_film_fk
+    FOREIGN KEY (film_id)
+    REFERENCES film (id)
+    ON DELETE NO ACTION
+    ON UPDATE NO ACTION);
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,10 @@
+BEGIN;
+
+ALTER TABLE planet DROP COLUMN rotation_period;
+ALTER TABLE planet DROP COLUMN orbital_period;
+ALTER TABLE planet DROP COLUMN diameter;
+ALTER TABLE planet DROP COLUMN gravity;
+ALTER TABLE planet DROP COLUMN surface_water;
+ALTER TABLE planet DROP COLUMN population;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,13 @@
+BEGIN;
+
+ALTER TABLE planet ADD COLUMN rotation_period INT NULL DEFAULT NULL;
+ALTER TABLE planet ADD COLUMN orbital_period INT NULL DEFAULT NULL;
+ALTER TABLE planet ADD COLUMN diameter INT NULL DEFAULT NULL;
+ALTER TABLE planet ADD COLUMN gravity VARCHAR(45) NOT NULL DEFAULT '';
+ALTER TABLE planet ADD COLUMN surface_water DOUBLE NULL DEFAULT NULL;
+ALTER TABLE planet ADD COLUMN population BIGINT NULL DEFAULT NULL;
+
+-- the next incremental import fills the new columns of the planets already imported
+UPDATE planet SET edited_at = NULL;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE film MODIFY COLUMN release_date TIMESTAMP NOT NULL;
+ALTER TABLE film DROP COLUMN producer;
+ALTER TABLE film DROP COLUMN opening_crawl;
+ALTER TABLE film DROP COLUMN episode_id;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,11 @@
+BEGIN;
+
+ALTER TABLE film ADD COLUMN episode_id INT NULL DEFAULT NULL;
+ALTER TABLE film ADD COLUMN opening_crawl TEXT NOT NULL;
+ALTER TABLE film ADD COLUMN producer VARCHAR(100) NOT NULL DEFAULT '';
+ALTER TABLE film MODIFY COLUMN release_date DATE NOT NULL;
+
+-- the next incremental import fills the new columns of the films already imported
+UPDATE film SET edited_at = NULL;
+
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,7 @@
+BEGIN;
+
+ALTER TABLE planet DROP INDEX UC_PLANET_NAME;
+ALTER TABLE planet DROP COLUMN active_name;
+ALTER TABLE planet ADD CONSTRAINT UC_PLANET_NAME UNIQUE (name);
+
+COMMIT;
\ No newline at end of file
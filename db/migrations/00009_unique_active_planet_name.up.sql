@@ -0,0 +1,8 @@
+BEGIN;
+
+-- deleted planets release their name, so UC_PLANET_NAME only applies to the active ones
+ALTER TABLE planet ADD COLUMN active_name VARCHAR(45) AS (IF(deleted_at IS NULL, name, NULL)) STORED;
+ALTER TABLE planet DROP INDEX UC_PLANET_NAME;
+ALTER TABLE planet ADD CONSTRAINT UC_PLANET_NAME UNIQUE (active_name);
+
+COMMIT;
\ No newline at end of file
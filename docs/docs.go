@@ -15,9 +15,9 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/planets": {
+        "/films": {
             "get": {
-                "description": "get planets",
+                "description": "get films, by page or, when the cursor is sent, by cursor with the pagination of ResponseFilmsByCursor",
                 "consumes": [
                     "application/json"
                 ],
@@ -25,26 +25,38 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "planets"
+                    "films"
                 ],
-                "summary": "List planets",
+                "summary": "List films",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "page",
+                        "description": "page, starting at 1",
                         "name": "page",
                         "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "limit",
+                        "description": "limit, at most 100",
                         "name": "limit",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "name",
-                        "name": "name",
+                        "description": "next_cursor or prev_cursor of a previous response, instead of page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "title",
+                        "name": "title",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "director",
+                        "name": "director",
                         "in": "query"
                     }
                 ],
@@ -52,7 +64,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/planet.ResponsePlanets"
+                            "$ref": "#/definitions/planet.ResponseFilms"
                         }
                     },
                     "400": {
@@ -76,9 +88,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/planets/{id}": {
+        "/films/{id}": {
             "get": {
-                "description": "get planet by ID",
+                "description": "get film by ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -86,13 +98,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "planets"
+                    "films"
                 ],
-                "summary": "Show a planet",
+                "summary": "Show a film",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Planet ID",
+                        "description": "Film ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -102,7 +114,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/planet.PlanetDB"
+                            "$ref": "#/definitions/planet.Film"
                         }
                     },
                     "400": {
@@ -124,9 +136,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "delete planet by ID",
+            }
+        },
+        "/films/{id}/planets": {
+            "get": {
+                "description": "get planets by film ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -134,21 +148,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "planets"
+                    "films"
                 ],
-                "summary": "Delete a planet",
+                "summary": "List the planets of a film",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Planet ID",
+                        "description": "Film ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page, starting at 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit, at most 100",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/planet.ResponsePlanets"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -170,91 +199,1499 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "errors_handler.ErrorsResponse": {
-            "type": "object",
-            "properties": {
-                "message": {
-                    "type": "string"
-                }
-            }
-        },
-        "generic.Pagination": {
-            "type": "object",
-            "properties": {
-                "count": {
-                    "type": "integer"
-                },
-                "next_page": {
-                    "type": "integer"
-                },
-                "previous_page": {
-                    "type": "integer"
-                }
-            }
         },
-        "planet.Film": {
-            "type": "object",
-            "properties": {
-                "created_at": {
-                    "type": "string"
-                },
-                "director": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "release_date": {
-                    "type": "string"
+        "/people": {
+            "get": {
+                "description": "get people",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "people"
+                ],
+                "summary": "List people",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page, starting at 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit, at most 100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "name",
+                        "name": "name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/people.ResponsePeople"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    }
                 }
             }
         },
-        "planet.PlanetDB": {
-            "type": "object",
-            "properties": {
-                "climate": {
-                    "type": "string"
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "deleted_at": {
-                    "type": "string"
-                },
-                "films": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/planet.Film"
+        "/people/{id}": {
+            "get": {
+                "description": "get people by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "people"
+                ],
+                "summary": "Show a people",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "People ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/people.PeopleDB"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
                     }
-                },
-                "id": {
-                    "type": "integer"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "terrain": {
-                    "type": "string"
                 }
             }
         },
-        "planet.ResponsePlanets": {
-            "type": "object",
-            "properties": {
-                "data": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/planet.PlanetDB"
-                    }
-                },
-                "pagination": {
-                    "$ref": "#/definitions/generic.Pagination"
+        "/planets": {
+            "get": {
+                "description": "get planets, by page or, when the cursor is sent, by cursor with the pagination of ResponsePlanetsByCursor",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "planets"
+                ],
+                "summary": "List planets",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page, starting at 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit, at most 100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor or prev_cursor of a previous response, instead of page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "comma separated fields, - for descending: name, climate, terrain, rotation_period, orbital_period, diameter, surface_water, population, created_at, film_count",
+                        "name": "sort",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "name",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "climates the planet must have, comma separated",
+                        "name": "climate",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "terrains the planet must have, comma separated",
+                        "name": "terrain",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "film ID",
+                        "name": "film_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "population greater than or equal (also _gt, _lt, _lte)",
+                        "name": "population_gte",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "population between min,max",
+                        "name": "population_between",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "diameter less than (also _gt, _gte, _lte)",
+                        "name": "diameter_lt",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "diameter between min,max",
+                        "name": "diameter_between",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "orbital period greater than or equal (also _gt, _lt, _lte)",
+                        "name": "orbital_period_gte",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "orbital period between min,max",
+                        "name": "orbital_period_between",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "rotation period greater than or equal (also _gt, _lt, _lte)",
+                        "name": "rotation_period_gte",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "rotation period between min,max",
+                        "name": "rotation_period_between",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "surface water greater than or equal (also _gt, _lt, _lte)",
+                        "name": "surface_water_gte",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "surface water between min,max",
+                        "name": "surface_water_between",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/planet.ResponsePlanets"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "create a planet, optionally linked to films",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "planets"
+                ],
+                "summary": "Create a planet",
+                "parameters": [
+                    {
+                        "description": "Planet",
+                        "name": "planet",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/planet.PlanetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/planet.PlanetDB"
+                        },
+                        "headers": {
+                            "Location": {
+                                "type": "string",
+                                "description": "/api/planets/{id}"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/planets/deleted": {
+            "get": {
+                "description": "get the deleted planets, the last deleted first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "planets"
+                ],
+                "summary": "List deleted planets",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page, starting at 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit, at most 100",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/planet.ResponsePlanets"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/planets/{id}": {
+            "get": {
+                "description": "get planet by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "planets"
+                ],
+                "summary": "Show a planet",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Planet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/planet.PlanetDB"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "replace every attribute and the film links of a planet",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "planets"
+                ],
+                "summary": "Replace a planet",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Planet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Planet",
+                        "name": "planet",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/planet.PlanetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/planet.PlanetDB"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "delete planet by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "planets"
+                ],
+                "summary": "Delete a planet",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Planet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "update some attributes of a planet with a JSON Merge Patch, null removes the value and film_ids replaces the film links",
+                "consumes": [
+                    "application/json",
+                    "application/merge-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "planets"
+                ],
+                "summary": "Update a planet",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Planet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Fields to update",
+                        "name": "patch",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/planet.PlanetRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/planet.PlanetDB"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/planets/{id}/films": {
+            "get": {
+                "description": "get films by planet ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "planets"
+                ],
+                "summary": "List the films of a planet",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Planet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page, starting at 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit, at most 100",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/planet.ResponseFilms"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/planets/{id}/restore": {
+            "post": {
+                "description": "restore a deleted planet and its film links",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "planets"
+                ],
+                "summary": "Restore a planet",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Planet ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/planet.PlanetDB"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/species": {
+            "get": {
+                "description": "get species",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "species"
+                ],
+                "summary": "List species",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page, starting at 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit, at most 100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "name",
+                        "name": "name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/species.ResponseSpecies"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/species/{id}": {
+            "get": {
+                "description": "get species by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "species"
+                ],
+                "summary": "Show a species",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Species ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/species.SpeciesDB"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/starships": {
+            "get": {
+                "description": "get starships",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "starships"
+                ],
+                "summary": "List starships",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page, starting at 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit, at most 100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "name",
+                        "name": "name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/starship.ResponseStarships"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/starships/{id}": {
+            "get": {
+                "description": "get starship by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "starships"
+                ],
+                "summary": "Show a starship",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Starship ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/starship.StarshipDB"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/vehicles": {
+            "get": {
+                "description": "get vehicles",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vehicles"
+                ],
+                "summary": "List vehicles",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "page, starting at 1",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit, at most 100",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "name",
+                        "name": "name",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/vehicle.ResponseVehicles"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/vehicles/{id}": {
+            "get": {
+                "description": "get vehicle by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vehicles"
+                ],
+                "summary": "Show a vehicle",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Vehicle ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/vehicle.VehicleDB"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/errors_handler.ErrorsResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "errors_handler.ErrorsResponse": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "generic.Pagination": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "next_cursor": {
+                    "type": "string"
+                },
+                "next_page": {
+                    "type": "integer"
+                },
+                "page": {
+                    "type": "integer"
+                },
+                "prev_cursor": {
+                    "type": "string"
+                },
+                "previous_page": {
+                    "type": "integer"
+                },
+                "total_pages": {
+                    "type": "integer"
+                }
+            }
+        },
+        "people.PeopleDB": {
+            "type": "object",
+            "properties": {
+                "birth_year": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "edited_at": {
+                    "type": "string"
+                },
+                "eye_color": {
+                    "type": "string"
+                },
+                "films": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/planet.Film"
+                    }
+                },
+                "gender": {
+                    "type": "string"
+                },
+                "hair_color": {
+                    "type": "string"
+                },
+                "height": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "mass": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "planet_id": {
+                    "type": "integer"
+                },
+                "skin_color": {
+                    "type": "string"
+                }
+            }
+        },
+        "people.ResponsePeople": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/people.PeopleDB"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/generic.Pagination"
+                }
+            }
+        },
+        "planet.Film": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "director": {
+                    "type": "string"
+                },
+                "edited_at": {
+                    "type": "string"
+                },
+                "episode_id": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "opening_crawl": {
+                    "type": "string"
+                },
+                "producer": {
+                    "type": "string"
+                },
+                "release_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "planet.PlanetDB": {
+            "type": "object",
+            "properties": {
+                "climate": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted_at": {
+                    "type": "string"
+                },
+                "diameter": {
+                    "type": "integer"
+                },
+                "edited_at": {
+                    "type": "string"
+                },
+                "films": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/planet.Film"
+                    }
+                },
+                "gravity": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "orbital_period": {
+                    "type": "integer"
+                },
+                "population": {
+                    "type": "integer"
+                },
+                "rotation_period": {
+                    "type": "integer"
+                },
+                "surface_water": {
+                    "type": "number"
+                },
+                "terrain": {
+                    "type": "string"
+                }
+            }
+        },
+        "planet.PlanetRequest": {
+            "type": "object",
+            "properties": {
+                "climate": {
+                    "type": "string"
+                },
+                "diameter": {
+                    "type": "integer"
+                },
+                "film_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "gravity": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "orbital_period": {
+                    "type": "integer"
+                },
+                "population": {
+                    "type": "integer"
+                },
+                "rotation_period": {
+                    "type": "integer"
+                },
+                "surface_water": {
+                    "type": "number"
+                },
+                "terrain": {
+                    "type": "string"
+                }
+            }
+        },
+        "planet.ResponseFilms": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/planet.Film"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/generic.Pagination"
+                }
+            }
+        },
+        "planet.ResponsePlanets": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/planet.PlanetDB"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/generic.Pagination"
+                }
+            }
+        },
+        "species.ResponseSpecies": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/species.SpeciesDB"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/generic.Pagination"
+                }
+            }
+        },
+        "species.SpeciesDB": {
+            "type": "object",
+            "properties": {
+                "average_height": {
+                    "type": "string"
+                },
+                "average_lifespan": {
+                    "type": "string"
+                },
+                "classification": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "designation": {
+                    "type": "string"
+                },
+                "edited_at": {
+                    "type": "string"
+                },
+                "eye_colors": {
+                    "type": "string"
+                },
+                "films": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/planet.Film"
+                    }
+                },
+                "hair_colors": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "language": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "skin_colors": {
+                    "type": "string"
+                }
+            }
+        },
+        "starship.ResponseStarships": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/starship.StarshipDB"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/generic.Pagination"
+                }
+            }
+        },
+        "starship.StarshipDB": {
+            "type": "object",
+            "properties": {
+                "cargo_capacity": {
+                    "type": "string"
+                },
+                "consumables": {
+                    "type": "string"
+                },
+                "cost_in_credits": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "crew": {
+                    "type": "string"
+                },
+                "edited_at": {
+                    "type": "string"
+                },
+                "films": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/planet.Film"
+                    }
+                },
+                "hyperdrive_rating": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "length": {
+                    "type": "string"
+                },
+                "manufacturer": {
+                    "type": "string"
+                },
+                "max_atmosphering_speed": {
+                    "type": "string"
+                },
+                "mglt": {
+                    "type": "string"
+                },
+                "model": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "passengers": {
+                    "type": "string"
+                },
+                "starship_class": {
+                    "type": "string"
+                }
+            }
+        },
+        "vehicle.ResponseVehicles": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/vehicle.VehicleDB"
+                    }
+                },
+                "pagination": {
+                    "$ref": "#/definitions/generic.Pagination"
+                }
+            }
+        },
+        "vehicle.VehicleDB": {
+            "type": "object",
+            "properties": {
+                "cargo_capacity": {
+                    "type": "string"
+                },
+                "consumables": {
+                    "type": "string"
+                },
+                "cost_in_credits": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "crew": {
+                    "type": "string"
+                },
+                "edited_at": {
+                    "type": "string"
+                },
+                "films": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/planet.Film"
+                    }
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "length": {
+                    "type": "string"
+                },
+                "manufacturer": {
+                    "type": "string"
+                },
+                "max_atmosphering_speed": {
+                    "type": "string"
+                },
+                "model": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "passengers": {
+                    "type": "string"
+                },
+                "vehicle_class": {
+                    "type": "string"
                 }
             }
         }
@@ -11,6 +11,7 @@ require (
 	github.com/joho/godotenv v1.5.0
 	github.com/sirupsen/logrus v1.9.0
 	github.com/swaggo/swag v1.8.10
+	golang.org/x/time v0.3.0
 	gopkg.in/natefinch/lumberjack.v2 v2.2.1
 )
 
@@ -2,31 +2,90 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
 	"log"
 	"os"
+	"os/signal"
+	"strconv"
 
 	"github.com/danilotadeu/star_wars/app"
+	importerModel "github.com/danilotadeu/star_wars/model/importer"
 	"github.com/danilotadeu/star_wars/server"
 	"github.com/danilotadeu/star_wars/store"
+	"github.com/danilotadeu/star_wars/store/swapi"
 	"github.com/joho/godotenv"
 )
 
 func main() {
+	incremental := flag.Bool("incremental", false, "update planets and films edited on SWAPI since the last import")
+	dryRun := flag.Bool("dry-run", false, "compare SWAPI with the database without writing anything")
+	format := flag.String("format", "text", "report format: text or json")
+	snapshot := flag.String("snapshot", "", "import from a directory of SWAPI json instead of the network (default $SWAPI_SNAPSHOT_DIR)")
+	record := flag.String("record", "", "save the SWAPI responses on a directory that -snapshot can replay")
+	flag.Parse()
+
+	if *format != "text" && *format != "json" {
+		log.Fatal("Error invalid -format: ", *format)
+	}
+
 	err := godotenv.Load()
 	if err != nil {
 		log.Fatal("Error loading .env file")
 	}
+
+	if len(*snapshot) > 0 && len(*record) > 0 {
+		log.Fatal("Error -snapshot and -record can not be used together")
+	}
+
+	workers := importerModel.DefaultWorkers
+	if envWorkers := os.Getenv("IMPORT_WORKERS"); len(envWorkers) > 0 {
+		workers, err = strconv.Atoi(envWorkers)
+		if err != nil {
+			log.Fatal("Error parsing IMPORT_WORKERS: ", err.Error())
+		}
+	}
+
+	config, err := swapi.ConfigFromEnv()
+	if err != nil {
+		log.Fatal("Error ", err.Error())
+	}
+	if len(*snapshot) > 0 || len(*record) > 0 {
+		config.SnapshotDir = *snapshot
+		config.RecordDir = *record
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
 	server := server.New()
 	db := server.ConnectDatabase()
-	store := store.Register(db, os.Getenv("URL_STARWARS_API"))
+	store := store.Register(db, os.Getenv("URL_STARWARS_API"), swapi.NewClient(config))
 	app := app.Register(store)
 
-	err = app.Planet.CreatePlanetsAndFilms(context.Background())
+	report, err := app.Planet.CreatePlanetsAndFilms(ctx, importerModel.Options{
+		Workers:     workers,
+		Incremental: *incremental,
+		DryRun:      *dryRun,
+	})
 	if err != nil {
 		log.Println("Happened a problem to import planet and movies: ", err.Error())
 		panic(err)
 	}
 	db.Close()
 
-	log.Println("Planets and movies created with successfully !!!")
+	if !*dryRun {
+		log.Println("Planets and movies created with successfully !!!")
+	}
+
+	if *format == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(report); err != nil {
+			log.Fatal("Error writing the report: ", err.Error())
+		}
+		return
+	}
+	fmt.Print(report.Text())
 }
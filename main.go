@@ -1,7 +1,9 @@
 package main
 
 import (
+	"flag"
 	"log"
+	"os"
 
 	serverInit "github.com/danilotadeu/star_wars/server"
 	_ "github.com/go-sql-driver/mysql"
@@ -22,5 +24,17 @@ func init() {
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "purge" {
+		purge := flag.NewFlagSet("purge", flag.ExitOnError)
+		defaultRetention, err := serverInit.PurgeRetention()
+		if err != nil {
+			log.Fatal(err)
+		}
+		retention := purge.Duration("retention", defaultRetention, "hard delete planets soft deleted longer than this ago")
+		_ = purge.Parse(os.Args[2:])
+		server.Purge(*retention)
+		return
+	}
+
 	server.Start()
 }
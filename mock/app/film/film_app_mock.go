@@ -0,0 +1,129 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/danilotadeu/star_wars/app/film (interfaces: App)
+
+// Package mockAppFilm is a generated GoMock package.
+package mockAppFilm
+
+import (
+	context "context"
+	reflect "reflect"
+
+	planet "github.com/danilotadeu/star_wars/model/film"
+	generic "github.com/danilotadeu/star_wars/model/generic"
+	planet0 "github.com/danilotadeu/star_wars/model/planet"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockApp is a mock of App interface.
+type MockApp struct {
+	ctrl     *gomock.Controller
+	recorder *MockAppMockRecorder
+}
+
+// MockAppMockRecorder is the mock recorder for MockApp.
+type MockAppMockRecorder struct {
+	mock *MockApp
+}
+
+// NewMockApp creates a new mock instance.
+func NewMockApp(ctrl *gomock.Controller) *MockApp {
+	mock := &MockApp{ctrl: ctrl}
+	mock.recorder = &MockAppMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApp) EXPECT() *MockAppMockRecorder {
+	return m.recorder
+}
+
+// GetAllFilms mocks base method.
+func (m *MockApp) GetAllFilms(arg0 context.Context, arg1, arg2 int64, arg3 planet.Filter) ([]*planet.Film, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllFilms", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*planet.Film)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllFilms indicates an expected call of GetAllFilms.
+func (mr *MockAppMockRecorder) GetAllFilms(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllFilms", reflect.TypeOf((*MockApp)(nil).GetAllFilms), arg0, arg1, arg2, arg3)
+}
+
+// GetFilmsByCursor mocks base method.
+func (m *MockApp) GetFilmsByCursor(arg0 context.Context, arg1 *generic.Cursor, arg2 int64, arg3 planet.Filter) ([]*planet.Film, *generic.CursorPagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilmsByCursor", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*planet.Film)
+	ret1, _ := ret[1].(*generic.CursorPagination)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetFilmsByCursor indicates an expected call of GetFilmsByCursor.
+func (mr *MockAppMockRecorder) GetFilmsByCursor(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmsByCursor", reflect.TypeOf((*MockApp)(nil).GetFilmsByCursor), arg0, arg1, arg2, arg3)
+}
+
+// GetOneByID mocks base method.
+func (m *MockApp) GetOneByID(arg0 context.Context, arg1 int64) (*planet.Film, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOneByID", arg0, arg1)
+	ret0, _ := ret[0].(*planet.Film)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOneByID indicates an expected call of GetOneByID.
+func (mr *MockAppMockRecorder) GetOneByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOneByID", reflect.TypeOf((*MockApp)(nil).GetOneByID), arg0, arg1)
+}
+
+// GetPlanetsByFilmID mocks base method.
+func (m *MockApp) GetPlanetsByFilmID(arg0 context.Context, arg1, arg2, arg3 int64) ([]*planet0.PlanetDB, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlanetsByFilmID", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*planet0.PlanetDB)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlanetsByFilmID indicates an expected call of GetPlanetsByFilmID.
+func (mr *MockAppMockRecorder) GetPlanetsByFilmID(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlanetsByFilmID", reflect.TypeOf((*MockApp)(nil).GetPlanetsByFilmID), arg0, arg1, arg2, arg3)
+}
+
+// GetTotalFilms mocks base method.
+func (m *MockApp) GetTotalFilms(arg0 context.Context, arg1 planet.Filter) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalFilms", arg0, arg1)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalFilms indicates an expected call of GetTotalFilms.
+func (mr *MockAppMockRecorder) GetTotalFilms(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalFilms", reflect.TypeOf((*MockApp)(nil).GetTotalFilms), arg0, arg1)
+}
+
+// GetTotalPlanetsByFilmID mocks base method.
+func (m *MockApp) GetTotalPlanetsByFilmID(arg0 context.Context, arg1 int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalPlanetsByFilmID", arg0, arg1)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalPlanetsByFilmID indicates an expected call of GetTotalPlanetsByFilmID.
+func (mr *MockAppMockRecorder) GetTotalPlanetsByFilmID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalPlanetsByFilmID", reflect.TypeOf((*MockApp)(nil).GetTotalPlanetsByFilmID), arg0, arg1)
+}
@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/danilotadeu/star_wars/app/people (interfaces: App)
+
+// Package mockAppPeople is a generated GoMock package.
+package mockAppPeople
+
+import (
+	context "context"
+	reflect "reflect"
+
+	people "github.com/danilotadeu/star_wars/model/people"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockApp is a mock of App interface.
+type MockApp struct {
+	ctrl     *gomock.Controller
+	recorder *MockAppMockRecorder
+}
+
+// MockAppMockRecorder is the mock recorder for MockApp.
+type MockAppMockRecorder struct {
+	mock *MockApp
+}
+
+// NewMockApp creates a new mock instance.
+func NewMockApp(ctrl *gomock.Controller) *MockApp {
+	mock := &MockApp{ctrl: ctrl}
+	mock.recorder = &MockAppMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApp) EXPECT() *MockAppMockRecorder {
+	return m.recorder
+}
+
+// GetAllPeople mocks base method.
+func (m *MockApp) GetAllPeople(arg0 context.Context, arg1, arg2 int64, arg3 string) ([]*people.PeopleDB, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllPeople", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*people.PeopleDB)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllPeople indicates an expected call of GetAllPeople.
+func (mr *MockAppMockRecorder) GetAllPeople(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPeople", reflect.TypeOf((*MockApp)(nil).GetAllPeople), arg0, arg1, arg2, arg3)
+}
+
+// GetOneByID mocks base method.
+func (m *MockApp) GetOneByID(arg0 context.Context, arg1 int64) (*people.PeopleDB, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOneByID", arg0, arg1)
+	ret0, _ := ret[0].(*people.PeopleDB)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOneByID indicates an expected call of GetOneByID.
+func (mr *MockAppMockRecorder) GetOneByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOneByID", reflect.TypeOf((*MockApp)(nil).GetOneByID), arg0, arg1)
+}
+
+// GetTotalPeople mocks base method.
+func (m *MockApp) GetTotalPeople(arg0 context.Context, arg1 string) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalPeople", arg0, arg1)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalPeople indicates an expected call of GetTotalPeople.
+func (mr *MockAppMockRecorder) GetTotalPeople(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalPeople", reflect.TypeOf((*MockApp)(nil).GetTotalPeople), arg0, arg1)
+}
@@ -7,8 +7,12 @@ package mockAppPlanet
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
-	planet "github.com/danilotadeu/star_wars/model/planet"
+	planet "github.com/danilotadeu/star_wars/model/film"
+	generic "github.com/danilotadeu/star_wars/model/generic"
+	importer "github.com/danilotadeu/star_wars/model/importer"
+	planet0 "github.com/danilotadeu/star_wars/model/planet"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -35,18 +39,34 @@ func (m *MockApp) EXPECT() *MockAppMockRecorder {
 	return m.recorder
 }
 
+// CreatePlanet mocks base method.
+func (m *MockApp) CreatePlanet(arg0 context.Context, arg1 planet0.PlanetRequest) (*planet0.PlanetDB, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePlanet", arg0, arg1)
+	ret0, _ := ret[0].(*planet0.PlanetDB)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePlanet indicates an expected call of CreatePlanet.
+func (mr *MockAppMockRecorder) CreatePlanet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePlanet", reflect.TypeOf((*MockApp)(nil).CreatePlanet), arg0, arg1)
+}
+
 // CreatePlanetsAndFilms mocks base method.
-func (m *MockApp) CreatePlanetsAndFilms(arg0 context.Context) error {
+func (m *MockApp) CreatePlanetsAndFilms(arg0 context.Context, arg1 importer.Options) (*importer.Report, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreatePlanetsAndFilms", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "CreatePlanetsAndFilms", arg0, arg1)
+	ret0, _ := ret[0].(*importer.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // CreatePlanetsAndFilms indicates an expected call of CreatePlanetsAndFilms.
-func (mr *MockAppMockRecorder) CreatePlanetsAndFilms(arg0 interface{}) *gomock.Call {
+func (mr *MockAppMockRecorder) CreatePlanetsAndFilms(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePlanetsAndFilms", reflect.TypeOf((*MockApp)(nil).CreatePlanetsAndFilms), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePlanetsAndFilms", reflect.TypeOf((*MockApp)(nil).CreatePlanetsAndFilms), arg0, arg1)
 }
 
 // Delete mocks base method.
@@ -64,10 +84,10 @@ func (mr *MockAppMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
 }
 
 // GetAllPlanets mocks base method.
-func (m *MockApp) GetAllPlanets(arg0 context.Context, arg1, arg2 int64, arg3 string) ([]*planet.PlanetDB, error) {
+func (m *MockApp) GetAllPlanets(arg0 context.Context, arg1, arg2 int64, arg3 planet0.Filter) ([]*planet0.PlanetDB, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAllPlanets", arg0, arg1, arg2, arg3)
-	ret0, _ := ret[0].([]*planet.PlanetDB)
+	ret0, _ := ret[0].([]*planet0.PlanetDB)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -78,11 +98,41 @@ func (mr *MockAppMockRecorder) GetAllPlanets(arg0, arg1, arg2, arg3 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPlanets", reflect.TypeOf((*MockApp)(nil).GetAllPlanets), arg0, arg1, arg2, arg3)
 }
 
+// GetDeletedPlanets mocks base method.
+func (m *MockApp) GetDeletedPlanets(arg0 context.Context, arg1, arg2 int64) ([]*planet0.PlanetDB, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedPlanets", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*planet0.PlanetDB)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedPlanets indicates an expected call of GetDeletedPlanets.
+func (mr *MockAppMockRecorder) GetDeletedPlanets(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedPlanets", reflect.TypeOf((*MockApp)(nil).GetDeletedPlanets), arg0, arg1, arg2)
+}
+
+// GetFilmsByPlanetID mocks base method.
+func (m *MockApp) GetFilmsByPlanetID(arg0 context.Context, arg1, arg2, arg3 int64) ([]*planet.Film, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilmsByPlanetID", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*planet.Film)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilmsByPlanetID indicates an expected call of GetFilmsByPlanetID.
+func (mr *MockAppMockRecorder) GetFilmsByPlanetID(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmsByPlanetID", reflect.TypeOf((*MockApp)(nil).GetFilmsByPlanetID), arg0, arg1, arg2, arg3)
+}
+
 // GetOneByID mocks base method.
-func (m *MockApp) GetOneByID(arg0 context.Context, arg1 int64) (*planet.PlanetDB, error) {
+func (m *MockApp) GetOneByID(arg0 context.Context, arg1 int64) (*planet0.PlanetDB, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetOneByID", arg0, arg1)
-	ret0, _ := ret[0].(*planet.PlanetDB)
+	ret0, _ := ret[0].(*planet0.PlanetDB)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -93,31 +143,123 @@ func (mr *MockAppMockRecorder) GetOneByID(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOneByID", reflect.TypeOf((*MockApp)(nil).GetOneByID), arg0, arg1)
 }
 
+// GetPlanetsByCursor mocks base method.
+func (m *MockApp) GetPlanetsByCursor(arg0 context.Context, arg1 *generic.Cursor, arg2 int64, arg3 planet0.Filter) ([]*planet0.PlanetDB, *generic.CursorPagination, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlanetsByCursor", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*planet0.PlanetDB)
+	ret1, _ := ret[1].(*generic.CursorPagination)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPlanetsByCursor indicates an expected call of GetPlanetsByCursor.
+func (mr *MockAppMockRecorder) GetPlanetsByCursor(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlanetsByCursor", reflect.TypeOf((*MockApp)(nil).GetPlanetsByCursor), arg0, arg1, arg2, arg3)
+}
+
+// GetTotalDeletedPlanets mocks base method.
+func (m *MockApp) GetTotalDeletedPlanets(arg0 context.Context) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalDeletedPlanets", arg0)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalDeletedPlanets indicates an expected call of GetTotalDeletedPlanets.
+func (mr *MockAppMockRecorder) GetTotalDeletedPlanets(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalDeletedPlanets", reflect.TypeOf((*MockApp)(nil).GetTotalDeletedPlanets), arg0)
+}
+
+// GetTotalFilmsByPlanetID mocks base method.
+func (m *MockApp) GetTotalFilmsByPlanetID(arg0 context.Context, arg1 int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalFilmsByPlanetID", arg0, arg1)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalFilmsByPlanetID indicates an expected call of GetTotalFilmsByPlanetID.
+func (mr *MockAppMockRecorder) GetTotalFilmsByPlanetID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalFilmsByPlanetID", reflect.TypeOf((*MockApp)(nil).GetTotalFilmsByPlanetID), arg0, arg1)
+}
+
 // GetTotalPlanets mocks base method.
-func (m *MockApp) GetTotalPlanets(arg0 context.Context) (*int64, error) {
+func (m *MockApp) GetTotalPlanets(arg0 context.Context, arg1 planet0.Filter) (*int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTotalPlanets", arg0)
+	ret := m.ctrl.Call(m, "GetTotalPlanets", arg0, arg1)
 	ret0, _ := ret[0].(*int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTotalPlanets indicates an expected call of GetTotalPlanets.
-func (mr *MockAppMockRecorder) GetTotalPlanets(arg0 interface{}) *gomock.Call {
+func (mr *MockAppMockRecorder) GetTotalPlanets(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalPlanets", reflect.TypeOf((*MockApp)(nil).GetTotalPlanets), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalPlanets", reflect.TypeOf((*MockApp)(nil).GetTotalPlanets), arg0, arg1)
 }
 
-// SaveFilms mocks base method.
-func (m *MockApp) SaveFilms(arg0 context.Context, arg1 []string, arg2 int64) error {
+// PatchPlanet mocks base method.
+func (m *MockApp) PatchPlanet(arg0 context.Context, arg1 int64, arg2 planet0.PlanetPatch) (*planet0.PlanetDB, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SaveFilms", arg0, arg1, arg2)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "PatchPlanet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*planet0.PlanetDB)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchPlanet indicates an expected call of PatchPlanet.
+func (mr *MockAppMockRecorder) PatchPlanet(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchPlanet", reflect.TypeOf((*MockApp)(nil).PatchPlanet), arg0, arg1, arg2)
+}
+
+// Purge mocks base method.
+func (m *MockApp) Purge(arg0 context.Context, arg1 time.Duration) (*planet0.PurgeReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", arg0, arg1)
+	ret0, _ := ret[0].(*planet0.PurgeReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockAppMockRecorder) Purge(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockApp)(nil).Purge), arg0, arg1)
+}
+
+// Restore mocks base method.
+func (m *MockApp) Restore(arg0 context.Context, arg1 int64) (*planet0.PlanetDB, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1)
+	ret0, _ := ret[0].(*planet0.PlanetDB)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockAppMockRecorder) Restore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockApp)(nil).Restore), arg0, arg1)
+}
+
+// UpdatePlanet mocks base method.
+func (m *MockApp) UpdatePlanet(arg0 context.Context, arg1 int64, arg2 planet0.PlanetRequest) (*planet0.PlanetDB, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePlanet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*planet0.PlanetDB)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// SaveFilms indicates an expected call of SaveFilms.
-func (mr *MockAppMockRecorder) SaveFilms(arg0, arg1, arg2 interface{}) *gomock.Call {
+// UpdatePlanet indicates an expected call of UpdatePlanet.
+func (mr *MockAppMockRecorder) UpdatePlanet(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveFilms", reflect.TypeOf((*MockApp)(nil).SaveFilms), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePlanet", reflect.TypeOf((*MockApp)(nil).UpdatePlanet), arg0, arg1, arg2)
 }
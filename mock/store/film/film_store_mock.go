@@ -6,9 +6,13 @@ package mockStoreFilm
 
 import (
 	context "context"
+	sql "database/sql"
 	reflect "reflect"
+	time "time"
 
 	planet "github.com/danilotadeu/star_wars/model/film"
+	generic "github.com/danilotadeu/star_wars/model/generic"
+	film "github.com/danilotadeu/star_wars/store/film"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -35,34 +39,93 @@ func (m *MockStore) EXPECT() *MockStoreMockRecorder {
 	return m.recorder
 }
 
-// GetFilmWithPlanet mocks base method.
-func (m *MockStore) GetFilmWithPlanet(arg0 context.Context, arg1, arg2 int64) (*planet.FilmPlanet, error) {
+// DeleteFilmsWithPlanet mocks base method.
+func (m *MockStore) DeleteFilmsWithPlanet(arg0 context.Context, arg1 int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFilmWithPlanet", arg0, arg1, arg2)
-	ret0, _ := ret[0].(*planet.FilmPlanet)
+	ret := m.ctrl.Call(m, "DeleteFilmsWithPlanet", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFilmsWithPlanet indicates an expected call of DeleteFilmsWithPlanet.
+func (mr *MockStoreMockRecorder) DeleteFilmsWithPlanet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFilmsWithPlanet", reflect.TypeOf((*MockStore)(nil).DeleteFilmsWithPlanet), arg0, arg1)
+}
+
+// GetAll mocks base method.
+func (m *MockStore) GetAll(arg0 context.Context, arg1, arg2 int64, arg3 planet.Filter) ([]*planet.Film, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*planet.Film)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetFilmWithPlanet indicates an expected call of GetFilmWithPlanet.
-func (mr *MockStoreMockRecorder) GetFilmWithPlanet(arg0, arg1, arg2 interface{}) *gomock.Call {
+// GetAll indicates an expected call of GetAll.
+func (mr *MockStoreMockRecorder) GetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmWithPlanet", reflect.TypeOf((*MockStore)(nil).GetFilmWithPlanet), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockStore)(nil).GetAll), arg0, arg1, arg2, arg3)
+}
+
+// GetAllByCursor mocks base method.
+func (m *MockStore) GetAllByCursor(arg0 context.Context, arg1 *generic.Cursor, arg2 int64, arg3 planet.Filter) ([]*planet.Film, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByCursor", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*planet.Film)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByCursor indicates an expected call of GetAllByCursor.
+func (mr *MockStoreMockRecorder) GetAllByCursor(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByCursor", reflect.TypeOf((*MockStore)(nil).GetAllByCursor), arg0, arg1, arg2, arg3)
+}
+
+// GetAllByPlanetID mocks base method.
+func (m *MockStore) GetAllByPlanetID(arg0 context.Context, arg1, arg2, arg3 int64) ([]*planet.Film, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByPlanetID", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*planet.Film)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByPlanetID indicates an expected call of GetAllByPlanetID.
+func (mr *MockStoreMockRecorder) GetAllByPlanetID(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByPlanetID", reflect.TypeOf((*MockStore)(nil).GetAllByPlanetID), arg0, arg1, arg2, arg3)
 }
 
-// GetFilms mocks base method.
-func (m *MockStore) GetFilms(arg0 context.Context, arg1 []string) ([]planet.ResultFilm, error) {
+// GetFilm mocks base method.
+func (m *MockStore) GetFilm(arg0 context.Context, arg1 string) (*planet.ResultFilm, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFilms", arg0, arg1)
-	ret0, _ := ret[0].([]planet.ResultFilm)
+	ret := m.ctrl.Call(m, "GetFilm", arg0, arg1)
+	ret0, _ := ret[0].(*planet.ResultFilm)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetFilms indicates an expected call of GetFilms.
-func (mr *MockStoreMockRecorder) GetFilms(arg0, arg1 interface{}) *gomock.Call {
+// GetFilm indicates an expected call of GetFilm.
+func (mr *MockStoreMockRecorder) GetFilm(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilms", reflect.TypeOf((*MockStore)(nil).GetFilms), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilm", reflect.TypeOf((*MockStore)(nil).GetFilm), arg0, arg1)
+}
+
+// GetFilmWithPlanet mocks base method.
+func (m *MockStore) GetFilmWithPlanet(arg0 context.Context, arg1, arg2 int64) (*planet.FilmPlanet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilmWithPlanet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*planet.FilmPlanet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilmWithPlanet indicates an expected call of GetFilmWithPlanet.
+func (mr *MockStoreMockRecorder) GetFilmWithPlanet(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmWithPlanet", reflect.TypeOf((*MockStore)(nil).GetFilmWithPlanet), arg0, arg1, arg2)
 }
 
 // GetFilmsByPlanetIDs mocks base method.
@@ -95,6 +158,66 @@ func (mr *MockStoreMockRecorder) GetOne(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOne", reflect.TypeOf((*MockStore)(nil).GetOne), arg0, arg1)
 }
 
+// GetOneByID mocks base method.
+func (m *MockStore) GetOneByID(arg0 context.Context, arg1 int64) (*planet.Film, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOneByID", arg0, arg1)
+	ret0, _ := ret[0].(*planet.Film)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOneByID indicates an expected call of GetOneByID.
+func (mr *MockStoreMockRecorder) GetOneByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOneByID", reflect.TypeOf((*MockStore)(nil).GetOneByID), arg0, arg1)
+}
+
+// GetTotalFilms mocks base method.
+func (m *MockStore) GetTotalFilms(arg0 context.Context, arg1 planet.Filter) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalFilms", arg0, arg1)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalFilms indicates an expected call of GetTotalFilms.
+func (mr *MockStoreMockRecorder) GetTotalFilms(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalFilms", reflect.TypeOf((*MockStore)(nil).GetTotalFilms), arg0, arg1)
+}
+
+// GetTotalFilmsByPlanetID mocks base method.
+func (m *MockStore) GetTotalFilmsByPlanetID(arg0 context.Context, arg1 int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalFilmsByPlanetID", arg0, arg1)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalFilmsByPlanetID indicates an expected call of GetTotalFilmsByPlanetID.
+func (mr *MockStoreMockRecorder) GetTotalFilmsByPlanetID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalFilmsByPlanetID", reflect.TypeOf((*MockStore)(nil).GetTotalFilmsByPlanetID), arg0, arg1)
+}
+
+// PurgeFilmsWithPlanet mocks base method.
+func (m *MockStore) PurgeFilmsWithPlanet(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeFilmsWithPlanet", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeFilmsWithPlanet indicates an expected call of PurgeFilmsWithPlanet.
+func (mr *MockStoreMockRecorder) PurgeFilmsWithPlanet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeFilmsWithPlanet", reflect.TypeOf((*MockStore)(nil).PurgeFilmsWithPlanet), arg0, arg1)
+}
+
 // SaveFilm mocks base method.
 func (m *MockStore) SaveFilm(arg0 context.Context, arg1 planet.ResultFilm) (*int64, error) {
 	m.ctrl.T.Helper()
@@ -124,3 +247,31 @@ func (mr *MockStoreMockRecorder) SaveFilmWithPlanet(arg0, arg1, arg2 interface{}
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveFilmWithPlanet", reflect.TypeOf((*MockStore)(nil).SaveFilmWithPlanet), arg0, arg1, arg2)
 }
+
+// UpdateFilm mocks base method.
+func (m *MockStore) UpdateFilm(arg0 context.Context, arg1 int64, arg2 planet.ResultFilm) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFilm", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateFilm indicates an expected call of UpdateFilm.
+func (mr *MockStoreMockRecorder) UpdateFilm(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFilm", reflect.TypeOf((*MockStore)(nil).UpdateFilm), arg0, arg1, arg2)
+}
+
+// WithTx mocks base method.
+func (m *MockStore) WithTx(arg0 *sql.Tx) film.Store {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", arg0)
+	ret0, _ := ret[0].(film.Store)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockStoreMockRecorder) WithTx(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockStore)(nil).WithTx), arg0)
+}
@@ -0,0 +1,216 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/danilotadeu/star_wars/store/people (interfaces: Store)
+
+// Package mockStorePeople is a generated GoMock package.
+package mockStorePeople
+
+import (
+	context "context"
+	sql "database/sql"
+	reflect "reflect"
+	time "time"
+
+	people "github.com/danilotadeu/star_wars/model/people"
+	people0 "github.com/danilotadeu/star_wars/store/people"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStore is a mock of Store interface.
+type MockStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoreMockRecorder
+}
+
+// MockStoreMockRecorder is the mock recorder for MockStore.
+type MockStoreMockRecorder struct {
+	mock *MockStore
+}
+
+// NewMockStore creates a new mock instance.
+func NewMockStore(ctrl *gomock.Controller) *MockStore {
+	mock := &MockStore{ctrl: ctrl}
+	mock.recorder = &MockStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStore) EXPECT() *MockStoreMockRecorder {
+	return m.recorder
+}
+
+// DetachDeletedHomeworld mocks base method.
+func (m *MockStore) DetachDeletedHomeworld(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachDeletedHomeworld", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetachDeletedHomeworld indicates an expected call of DetachDeletedHomeworld.
+func (mr *MockStoreMockRecorder) DetachDeletedHomeworld(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachDeletedHomeworld", reflect.TypeOf((*MockStore)(nil).DetachDeletedHomeworld), arg0, arg1)
+}
+
+// GetAll mocks base method.
+func (m *MockStore) GetAll(arg0 context.Context, arg1, arg2 int64, arg3 string) ([]*people.PeopleDB, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*people.PeopleDB)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockStoreMockRecorder) GetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockStore)(nil).GetAll), arg0, arg1, arg2, arg3)
+}
+
+// GetFilmWithPeople mocks base method.
+func (m *MockStore) GetFilmWithPeople(arg0 context.Context, arg1, arg2 int64) (*people.FilmPeople, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilmWithPeople", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*people.FilmPeople)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilmWithPeople indicates an expected call of GetFilmWithPeople.
+func (mr *MockStoreMockRecorder) GetFilmWithPeople(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmWithPeople", reflect.TypeOf((*MockStore)(nil).GetFilmWithPeople), arg0, arg1, arg2)
+}
+
+// GetFilmsByPeopleIDs mocks base method.
+func (m *MockStore) GetFilmsByPeopleIDs(arg0 context.Context, arg1 []int64) ([]people.FilmPeople, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilmsByPeopleIDs", arg0, arg1)
+	ret0, _ := ret[0].([]people.FilmPeople)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilmsByPeopleIDs indicates an expected call of GetFilmsByPeopleIDs.
+func (mr *MockStoreMockRecorder) GetFilmsByPeopleIDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmsByPeopleIDs", reflect.TypeOf((*MockStore)(nil).GetFilmsByPeopleIDs), arg0, arg1)
+}
+
+// GetOne mocks base method.
+func (m *MockStore) GetOne(arg0 context.Context, arg1 string) (*people.PeopleDB, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOne", arg0, arg1)
+	ret0, _ := ret[0].(*people.PeopleDB)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOne indicates an expected call of GetOne.
+func (mr *MockStoreMockRecorder) GetOne(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOne", reflect.TypeOf((*MockStore)(nil).GetOne), arg0, arg1)
+}
+
+// GetOneByID mocks base method.
+func (m *MockStore) GetOneByID(arg0 context.Context, arg1 int64) (*people.PeopleDB, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOneByID", arg0, arg1)
+	ret0, _ := ret[0].(*people.PeopleDB)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOneByID indicates an expected call of GetOneByID.
+func (mr *MockStoreMockRecorder) GetOneByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOneByID", reflect.TypeOf((*MockStore)(nil).GetOneByID), arg0, arg1)
+}
+
+// GetPeople mocks base method.
+func (m *MockStore) GetPeople(arg0 context.Context, arg1 string) (*people.ResultPeople, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPeople", arg0, arg1)
+	ret0, _ := ret[0].(*people.ResultPeople)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPeople indicates an expected call of GetPeople.
+func (mr *MockStoreMockRecorder) GetPeople(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPeople", reflect.TypeOf((*MockStore)(nil).GetPeople), arg0, arg1)
+}
+
+// GetTotalPeople mocks base method.
+func (m *MockStore) GetTotalPeople(arg0 context.Context, arg1 string) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalPeople", arg0, arg1)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalPeople indicates an expected call of GetTotalPeople.
+func (mr *MockStoreMockRecorder) GetTotalPeople(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalPeople", reflect.TypeOf((*MockStore)(nil).GetTotalPeople), arg0, arg1)
+}
+
+// SaveFilmWithPeople mocks base method.
+func (m *MockStore) SaveFilmWithPeople(arg0 context.Context, arg1, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveFilmWithPeople", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveFilmWithPeople indicates an expected call of SaveFilmWithPeople.
+func (mr *MockStoreMockRecorder) SaveFilmWithPeople(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveFilmWithPeople", reflect.TypeOf((*MockStore)(nil).SaveFilmWithPeople), arg0, arg1, arg2)
+}
+
+// SavePeople mocks base method.
+func (m *MockStore) SavePeople(arg0 context.Context, arg1 people.ResultPeople, arg2 *int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePeople", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SavePeople indicates an expected call of SavePeople.
+func (mr *MockStoreMockRecorder) SavePeople(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePeople", reflect.TypeOf((*MockStore)(nil).SavePeople), arg0, arg1, arg2)
+}
+
+// UpdatePeople mocks base method.
+func (m *MockStore) UpdatePeople(arg0 context.Context, arg1 int64, arg2 people.ResultPeople, arg3 *int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePeople", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePeople indicates an expected call of UpdatePeople.
+func (mr *MockStoreMockRecorder) UpdatePeople(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePeople", reflect.TypeOf((*MockStore)(nil).UpdatePeople), arg0, arg1, arg2, arg3)
+}
+
+// WithTx mocks base method.
+func (m *MockStore) WithTx(arg0 *sql.Tx) people0.Store {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", arg0)
+	ret0, _ := ret[0].(people0.Store)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockStoreMockRecorder) WithTx(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockStore)(nil).WithTx), arg0)
+}
@@ -6,9 +6,13 @@ package mockStorePlanet
 
 import (
 	context "context"
+	sql "database/sql"
 	reflect "reflect"
+	time "time"
 
+	generic "github.com/danilotadeu/star_wars/model/generic"
 	planet "github.com/danilotadeu/star_wars/model/planet"
+	planet0 "github.com/danilotadeu/star_wars/store/planet"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -35,6 +39,21 @@ func (m *MockStore) EXPECT() *MockStoreMockRecorder {
 	return m.recorder
 }
 
+// CreatePlanet mocks base method.
+func (m *MockStore) CreatePlanet(arg0 context.Context, arg1 planet.PlanetRequest) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePlanet", arg0, arg1)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePlanet indicates an expected call of CreatePlanet.
+func (mr *MockStoreMockRecorder) CreatePlanet(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePlanet", reflect.TypeOf((*MockStore)(nil).CreatePlanet), arg0, arg1)
+}
+
 // Delete mocks base method.
 func (m *MockStore) Delete(arg0 context.Context, arg1 int64) error {
 	m.ctrl.T.Helper()
@@ -50,7 +69,7 @@ func (mr *MockStoreMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
 }
 
 // GetAll mocks base method.
-func (m *MockStore) GetAll(arg0 context.Context, arg1, arg2 int64, arg3 string) ([]*planet.PlanetDB, error) {
+func (m *MockStore) GetAll(arg0 context.Context, arg1, arg2 int64, arg3 planet.Filter) ([]*planet.PlanetDB, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAll", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].([]*planet.PlanetDB)
@@ -64,6 +83,81 @@ func (mr *MockStoreMockRecorder) GetAll(arg0, arg1, arg2, arg3 interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockStore)(nil).GetAll), arg0, arg1, arg2, arg3)
 }
 
+// GetAllByCursor mocks base method.
+func (m *MockStore) GetAllByCursor(arg0 context.Context, arg1 *generic.Cursor, arg2 int64, arg3 planet.Filter) ([]*planet.PlanetDB, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByCursor", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*planet.PlanetDB)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByCursor indicates an expected call of GetAllByCursor.
+func (mr *MockStoreMockRecorder) GetAllByCursor(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByCursor", reflect.TypeOf((*MockStore)(nil).GetAllByCursor), arg0, arg1, arg2, arg3)
+}
+
+// GetAllByFilmID mocks base method.
+func (m *MockStore) GetAllByFilmID(arg0 context.Context, arg1, arg2, arg3 int64) ([]*planet.PlanetDB, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllByFilmID", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*planet.PlanetDB)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllByFilmID indicates an expected call of GetAllByFilmID.
+func (mr *MockStoreMockRecorder) GetAllByFilmID(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllByFilmID", reflect.TypeOf((*MockStore)(nil).GetAllByFilmID), arg0, arg1, arg2, arg3)
+}
+
+// GetAllDeleted mocks base method.
+func (m *MockStore) GetAllDeleted(arg0 context.Context, arg1, arg2 int64) ([]*planet.PlanetDB, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllDeleted", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*planet.PlanetDB)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllDeleted indicates an expected call of GetAllDeleted.
+func (mr *MockStoreMockRecorder) GetAllDeleted(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDeleted", reflect.TypeOf((*MockStore)(nil).GetAllDeleted), arg0, arg1, arg2)
+}
+
+// GetDeletedByID mocks base method.
+func (m *MockStore) GetDeletedByID(arg0 context.Context, arg1 int64) (*planet.PlanetDB, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedByID", arg0, arg1)
+	ret0, _ := ret[0].(*planet.PlanetDB)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedByID indicates an expected call of GetDeletedByID.
+func (mr *MockStoreMockRecorder) GetDeletedByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedByID", reflect.TypeOf((*MockStore)(nil).GetDeletedByID), arg0, arg1)
+}
+
+// GetDeletedByName mocks base method.
+func (m *MockStore) GetDeletedByName(arg0 context.Context, arg1 string) (*planet.PlanetDB, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeletedByName", arg0, arg1)
+	ret0, _ := ret[0].(*planet.PlanetDB)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeletedByName indicates an expected call of GetDeletedByName.
+func (mr *MockStoreMockRecorder) GetDeletedByName(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeletedByName", reflect.TypeOf((*MockStore)(nil).GetDeletedByName), arg0, arg1)
+}
+
 // GetOne mocks base method.
 func (m *MockStore) GetOne(arg0 context.Context, arg1 string) (*planet.PlanetDB, error) {
 	m.ctrl.T.Helper()
@@ -94,34 +188,107 @@ func (mr *MockStoreMockRecorder) GetOneByID(arg0, arg1 interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOneByID", reflect.TypeOf((*MockStore)(nil).GetOneByID), arg0, arg1)
 }
 
-// GetPlanets mocks base method.
-func (m *MockStore) GetPlanets(arg0 context.Context) ([]planet.ResultPlanet, error) {
+// GetPlanetsPage mocks base method.
+func (m *MockStore) GetPlanetsPage(arg0 context.Context, arg1 int) (*planet.ResultPlanet, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPlanets", arg0)
-	ret0, _ := ret[0].([]planet.ResultPlanet)
+	ret := m.ctrl.Call(m, "GetPlanetsPage", arg0, arg1)
+	ret0, _ := ret[0].(*planet.ResultPlanet)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetPlanets indicates an expected call of GetPlanets.
-func (mr *MockStoreMockRecorder) GetPlanets(arg0 interface{}) *gomock.Call {
+// GetPlanetsPage indicates an expected call of GetPlanetsPage.
+func (mr *MockStoreMockRecorder) GetPlanetsPage(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlanets", reflect.TypeOf((*MockStore)(nil).GetPlanets), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlanetsPage", reflect.TypeOf((*MockStore)(nil).GetPlanetsPage), arg0, arg1)
+}
+
+// GetTotalDeletedPlanets mocks base method.
+func (m *MockStore) GetTotalDeletedPlanets(arg0 context.Context) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalDeletedPlanets", arg0)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalDeletedPlanets indicates an expected call of GetTotalDeletedPlanets.
+func (mr *MockStoreMockRecorder) GetTotalDeletedPlanets(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalDeletedPlanets", reflect.TypeOf((*MockStore)(nil).GetTotalDeletedPlanets), arg0)
 }
 
 // GetTotalPlanets mocks base method.
-func (m *MockStore) GetTotalPlanets(arg0 context.Context) (*int64, error) {
+func (m *MockStore) GetTotalPlanets(arg0 context.Context, arg1 planet.Filter) (*int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetTotalPlanets", arg0)
+	ret := m.ctrl.Call(m, "GetTotalPlanets", arg0, arg1)
 	ret0, _ := ret[0].(*int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetTotalPlanets indicates an expected call of GetTotalPlanets.
-func (mr *MockStoreMockRecorder) GetTotalPlanets(arg0 interface{}) *gomock.Call {
+func (mr *MockStoreMockRecorder) GetTotalPlanets(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalPlanets", reflect.TypeOf((*MockStore)(nil).GetTotalPlanets), arg0, arg1)
+}
+
+// GetTotalPlanetsByFilmID mocks base method.
+func (m *MockStore) GetTotalPlanetsByFilmID(arg0 context.Context, arg1 int64) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalPlanetsByFilmID", arg0, arg1)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalPlanetsByFilmID indicates an expected call of GetTotalPlanetsByFilmID.
+func (mr *MockStoreMockRecorder) GetTotalPlanetsByFilmID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalPlanetsByFilmID", reflect.TypeOf((*MockStore)(nil).GetTotalPlanetsByFilmID), arg0, arg1)
+}
+
+// Purge mocks base method.
+func (m *MockStore) Purge(arg0 context.Context, arg1 time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockStoreMockRecorder) Purge(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalPlanets", reflect.TypeOf((*MockStore)(nil).GetTotalPlanets), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockStore)(nil).Purge), arg0, arg1)
+}
+
+// ReplacePlanet mocks base method.
+func (m *MockStore) ReplacePlanet(arg0 context.Context, arg1 int64, arg2 planet.PlanetRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplacePlanet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplacePlanet indicates an expected call of ReplacePlanet.
+func (mr *MockStoreMockRecorder) ReplacePlanet(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplacePlanet", reflect.TypeOf((*MockStore)(nil).ReplacePlanet), arg0, arg1, arg2)
+}
+
+// Restore mocks base method.
+func (m *MockStore) Restore(arg0 context.Context, arg1 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockStoreMockRecorder) Restore(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockStore)(nil).Restore), arg0, arg1)
 }
 
 // SavePlanet mocks base method.
@@ -138,3 +305,31 @@ func (mr *MockStoreMockRecorder) SavePlanet(arg0, arg1 interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePlanet", reflect.TypeOf((*MockStore)(nil).SavePlanet), arg0, arg1)
 }
+
+// UpdatePlanet mocks base method.
+func (m *MockStore) UpdatePlanet(arg0 context.Context, arg1 int64, arg2 planet.Planet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePlanet", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePlanet indicates an expected call of UpdatePlanet.
+func (mr *MockStoreMockRecorder) UpdatePlanet(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePlanet", reflect.TypeOf((*MockStore)(nil).UpdatePlanet), arg0, arg1, arg2)
+}
+
+// WithTx mocks base method.
+func (m *MockStore) WithTx(arg0 *sql.Tx) planet0.Store {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", arg0)
+	ret0, _ := ret[0].(planet0.Store)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockStoreMockRecorder) WithTx(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockStore)(nil).WithTx), arg0)
+}
@@ -0,0 +1,201 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/danilotadeu/star_wars/store/species (interfaces: Store)
+
+// Package mockStoreSpecies is a generated GoMock package.
+package mockStoreSpecies
+
+import (
+	context "context"
+	sql "database/sql"
+	reflect "reflect"
+
+	resource "github.com/danilotadeu/star_wars/model/resource"
+	species "github.com/danilotadeu/star_wars/model/species"
+	species0 "github.com/danilotadeu/star_wars/store/species"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockStore is a mock of Store interface.
+type MockStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockStoreMockRecorder
+}
+
+// MockStoreMockRecorder is the mock recorder for MockStore.
+type MockStoreMockRecorder struct {
+	mock *MockStore
+}
+
+// NewMockStore creates a new mock instance.
+func NewMockStore(ctrl *gomock.Controller) *MockStore {
+	mock := &MockStore{ctrl: ctrl}
+	mock.recorder = &MockStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStore) EXPECT() *MockStoreMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockStore) Get(arg0 context.Context, arg1 string) (*species.ResultSpecies, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0, arg1)
+	ret0, _ := ret[0].(*species.ResultSpecies)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockStoreMockRecorder) Get(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockStore)(nil).Get), arg0, arg1)
+}
+
+// GetAll mocks base method.
+func (m *MockStore) GetAll(arg0 context.Context, arg1, arg2 int64, arg3 string) ([]*species.SpeciesDB, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]*species.SpeciesDB)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockStoreMockRecorder) GetAll(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockStore)(nil).GetAll), arg0, arg1, arg2, arg3)
+}
+
+// GetFilm mocks base method.
+func (m *MockStore) GetFilm(arg0 context.Context, arg1, arg2 int64) (*resource.FilmLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilm", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*resource.FilmLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilm indicates an expected call of GetFilm.
+func (mr *MockStoreMockRecorder) GetFilm(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilm", reflect.TypeOf((*MockStore)(nil).GetFilm), arg0, arg1, arg2)
+}
+
+// GetFilmsByIDs mocks base method.
+func (m *MockStore) GetFilmsByIDs(arg0 context.Context, arg1 []int64) ([]resource.FilmLink, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFilmsByIDs", arg0, arg1)
+	ret0, _ := ret[0].([]resource.FilmLink)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFilmsByIDs indicates an expected call of GetFilmsByIDs.
+func (mr *MockStoreMockRecorder) GetFilmsByIDs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFilmsByIDs", reflect.TypeOf((*MockStore)(nil).GetFilmsByIDs), arg0, arg1)
+}
+
+// GetOne mocks base method.
+func (m *MockStore) GetOne(arg0 context.Context, arg1 string) (*species.SpeciesDB, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOne", arg0, arg1)
+	ret0, _ := ret[0].(*species.SpeciesDB)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOne indicates an expected call of GetOne.
+func (mr *MockStoreMockRecorder) GetOne(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOne", reflect.TypeOf((*MockStore)(nil).GetOne), arg0, arg1)
+}
+
+// GetOneByID mocks base method.
+func (m *MockStore) GetOneByID(arg0 context.Context, arg1 int64) (*species.SpeciesDB, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOneByID", arg0, arg1)
+	ret0, _ := ret[0].(*species.SpeciesDB)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOneByID indicates an expected call of GetOneByID.
+func (mr *MockStoreMockRecorder) GetOneByID(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOneByID", reflect.TypeOf((*MockStore)(nil).GetOneByID), arg0, arg1)
+}
+
+// GetTotal mocks base method.
+func (m *MockStore) GetTotal(arg0 context.Context, arg1 string) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotal", arg0, arg1)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotal indicates an expected call of GetTotal.
+func (mr *MockStoreMockRecorder) GetTotal(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotal", reflect.TypeOf((*MockStore)(nil).GetTotal), arg0, arg1)
+}
+
+// Save mocks base method.
+func (m *MockStore) Save(arg0 context.Context, arg1 species.ResultSpecies) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", arg0, arg1)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockStoreMockRecorder) Save(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockStore)(nil).Save), arg0, arg1)
+}
+
+// SaveFilm mocks base method.
+func (m *MockStore) SaveFilm(arg0 context.Context, arg1, arg2 int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveFilm", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveFilm indicates an expected call of SaveFilm.
+func (mr *MockStoreMockRecorder) SaveFilm(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveFilm", reflect.TypeOf((*MockStore)(nil).SaveFilm), arg0, arg1, arg2)
+}
+
+// Update mocks base method.
+func (m *MockStore) Update(arg0 context.Context, arg1 int64, arg2 species.ResultSpecies) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockStoreMockRecorder) Update(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockStore)(nil).Update), arg0, arg1, arg2)
+}
+
+// WithTx mocks base method.
+func (m *MockStore) WithTx(arg0 *sql.Tx) species0.Store {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithTx", arg0)
+	ret0, _ := ret[0].(species0.Store)
+	return ret0
+}
+
+// WithTx indicates an expected call of WithTx.
+func (mr *MockStoreMockRecorder) WithTx(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithTx", reflect.TypeOf((*MockStore)(nil).WithTx), arg0)
+}
@@ -2,15 +2,24 @@ package planet
 
 import (
 	"errors"
+	"strconv"
 	"time"
+
+	genericModel "github.com/danilotadeu/star_wars/model/generic"
 )
 
 var ErrorFilmNotFound = errors.New("Film not found")
 var ErrorFilmPlanetNotFound = errors.New("Film Planet not found")
 
+// CursorSort is the sort of the film cursors, the listing is always ordered by episode..
+const CursorSort = "episode_id"
+
+// ReleaseDateLayout is the format of release_date on SWAPI..
+const ReleaseDateLayout = "2006-01-02"
+
 type ResultFilm struct {
 	Title        string    `json:"title"`
-	EpisodeID    int       `json:"episode_id"`
+	EpisodeID    *int      `json:"episode_id"`
 	OpeningCrawl string    `json:"opening_crawl"`
 	Director     string    `json:"director"`
 	Producer     string    `json:"producer"`
@@ -26,11 +35,30 @@ type ResultFilm struct {
 }
 
 type Film struct {
-	ID          int64     `json:"id"`
-	Name        string    `json:"name"`
-	Director    string    `json:"director"`
-	ReleaseDate time.Time `json:"release_date"`
-	CreatedAt   time.Time `json:"created_at"`
+	ID           int64      `json:"id"`
+	Name         string     `json:"name"`
+	EpisodeID    *int64     `json:"episode_id"`
+	OpeningCrawl string     `json:"opening_crawl"`
+	Director     string     `json:"director"`
+	Producer     string     `json:"producer"`
+	ReleaseDate  time.Time  `json:"release_date"`
+	CreatedAt    time.Time  `json:"created_at"`
+	EditedAt     *time.Time `json:"edited_at,omitempty"`
+}
+
+// Cursor is the position of the film on the keyset pagination of the listing, ordered by episode
+// with the films without one last: the keys tell if the episode is missing and its value, empty when it is..
+func (f *Film) Cursor() genericModel.Cursor {
+	keys := []string{"1", ""}
+	if f.EpisodeID != nil {
+		keys = []string{"0", strconv.FormatInt(*f.EpisodeID, 10)}
+	}
+
+	return genericModel.Cursor{
+		Sort: CursorSort,
+		Keys: keys,
+		ID:   f.ID,
+	}
 }
 
 type FilmPlanet struct {
@@ -40,3 +68,24 @@ type FilmPlanet struct {
 	DeletedAt *time.Time `json:"deleted_at"`
 	Film      Film
 }
+
+// Filter is the search on the list of films..
+type Filter struct {
+	Title    string
+	Director string
+}
+
+type FilmTotal struct {
+	Total int64 `json:"total"`
+}
+
+type ResponseFilms struct {
+	Data               []*Film                 `json:"data"`
+	ResponsePagination genericModel.Pagination `json:"pagination"`
+}
+
+// ResponseFilmsByCursor is a page of the listing read by cursor..
+type ResponseFilmsByCursor struct {
+	Data               []*Film                       `json:"data"`
+	ResponsePagination genericModel.CursorPagination `json:"pagination"`
+}
@@ -0,0 +1,17 @@
+package planet
+
+import (
+	"testing"
+
+	genericModel "github.com/danilotadeu/star_wars/model/generic"
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestCursor(t *testing.T) {
+	var episodeID int64 = 4
+	film := Film{ID: 1, Name: "A New Hope", EpisodeID: &episodeID}
+	withoutEpisode := Film{ID: 7, Name: "The Clone Wars"}
+
+	assert.Equal(t, genericModel.Cursor{Sort: CursorSort, Keys: []string{"0", "4"}, ID: 1}, film.Cursor())
+	assert.Equal(t, genericModel.Cursor{Sort: CursorSort, Keys: []string{"1", ""}, ID: 7}, withoutEpisode.Cursor())
+}
@@ -1,21 +1,213 @@
 package generic
 
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+var ErrorInvalidPagination = errors.New("Page and limit must be greater than zero")
+var ErrorInvalidCursor = errors.New("Invalid cursor")
+
+const (
+	// DefaultLimit is the page size when the limit is not sent..
+	DefaultLimit int64 = 10
+	// MaxLimit is the biggest page size, bigger limits are reduced to it..
+	MaxLimit int64 = 100
+)
+
+// Pagination is the pagination of a listing read by page. The cursors around the page are sent
+// when the listing also accepts the keyset pagination..
 type Pagination struct {
-	Count        int64  `json:"count"`
-	NextPage     *int64 `json:"next_page"`
-	PreviousPage *int64 `json:"previous_page"`
+	Count        int64   `json:"count"`
+	NextPage     *int64  `json:"next_page"`
+	PreviousPage *int64  `json:"previous_page"`
+	Page         int64   `json:"page"`
+	Limit        int64   `json:"limit"`
+	TotalPages   int64   `json:"total_pages"`
+	NextCursor   *string `json:"next_cursor,omitempty"`
+	PrevCursor   *string `json:"prev_cursor,omitempty"`
+}
+
+// CursorPagination is the pagination of a listing read by cursor, without pages..
+type CursorPagination struct {
+	Count      int64   `json:"count"`
+	Limit      int64   `json:"limit"`
+	NextCursor *string `json:"next_cursor"`
+	PrevCursor *string `json:"prev_cursor"`
+}
+
+// Cursor is the position of a row on a keyset pagination: the sort it was made for, the values of its
+// sort key and its id. Backward cursors read the rows before the position instead of after..
+type Cursor struct {
+	Sort     string   `json:"s,omitempty"`
+	Keys     []string `json:"k,omitempty"`
+	ID       int64    `json:"id"`
+	Backward bool     `json:"b,omitempty"`
+}
+
+// Encode return the opaque value of the cursor sent to the clients..
+func (c Cursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Values return the keys followed by the id, in the order of the keyset columns..
+func (c Cursor) Values() []interface{} {
+	values := make([]interface{}, 0, len(c.Keys)+1)
+	for _, key := range c.Keys {
+		values = append(values, key)
+	}
+
+	return append(values, c.ID)
+}
+
+// DecodeCursor read a cursor sent by a client, ErrorInvalidCursor when it was not made by Encode..
+func DecodeCursor(value string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, ErrorInvalidCursor
+	}
+
+	var cursor Cursor
+	err = json.Unmarshal(data, &cursor)
+	if err != nil || cursor.ID < 1 {
+		return nil, ErrorInvalidCursor
+	}
+
+	return &cursor, nil
+}
+
+// NewCursorPagination build the pagination of a page read from cursor, nil for the first page.
+// first and last are the cursors of the rows of the page and more tells if rows were read past it..
+func NewCursorPagination(cursor *Cursor, limit int64, more bool, first, last Cursor) CursorPagination {
+	pagination := CursorPagination{
+		Limit: limit,
+	}
+
+	backward := cursor != nil && cursor.Backward
+	if more || backward {
+		last.Backward = false
+		nextCursor := last.Encode()
+		pagination.NextCursor = &nextCursor
+	}
+
+	if (more && backward) || (cursor != nil && !backward) {
+		first.Backward = true
+		prevCursor := first.Encode()
+		pagination.PrevCursor = &prevCursor
+	}
+
+	return pagination
+}
+
+// WithCursors add to a pagination by page the cursors of the pages around it,
+// so the client can go on by cursor..
+func (p Pagination) WithCursors(first, last Cursor) Pagination {
+	if p.NextPage != nil {
+		last.Backward = false
+		nextCursor := last.Encode()
+		p.NextCursor = &nextCursor
+	}
+
+	if p.PreviousPage != nil {
+		first.Backward = true
+		prevCursor := first.Encode()
+		p.PrevCursor = &prevCursor
+	}
+
+	return p
+}
+
+// NewPagination build the pagination of a page, starting at 1, of count rows..
+func NewPagination(page, limit, count int64) Pagination {
+	totalPages := (count + limit - 1) / limit
+
+	pagination := Pagination{
+		Count:      count,
+		Page:       page,
+		Limit:      limit,
+		TotalPages: totalPages,
+	}
+
+	if page < totalPages {
+		nextPage := page + 1
+		pagination.NextPage = &nextPage
+	}
+
+	if page > 1 {
+		previousPage := page - 1
+		pagination.PreviousPage = &previousPage
+	}
+
+	return pagination
+}
+
+// ParseLimit read the limit of a listing: DefaultLimit when it is not sent and at most MaxLimit.
+// ErrorInvalidPagination when it is smaller than 1..
+func ParseLimit(value string) (int64, error) {
+	if len(value) == 0 {
+		return DefaultLimit, nil
+	}
+
+	limit, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	if limit < 1 {
+		return 0, ErrorInvalidPagination
+	}
+
+	if limit > MaxLimit {
+		limit = MaxLimit
+	}
+
+	return limit, nil
+}
+
+// ParsePage read the page of a listing, starting at 1 and 1 when it is not sent..
+func ParsePage(value string) (int64, error) {
+	if len(value) == 0 {
+		return 1, nil
+	}
+
+	page, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	if page < 1 {
+		return 0, ErrorInvalidPagination
+	}
+
+	return page, nil
 }
 
-func MakePagination(page int64) (*int64, *int64) {
-	nPage := page + 1
-	nextPage := &nPage
+// Offset is the number of rows skipped before a page, starting at 1..
+func Offset(page, limit int64) int64 {
+	return (page - 1) * limit
+}
+
+// ParseInt read a number of SWAPI, that can have thousand separators, returning nil
+// for "unknown" and any other value that is not a number..
+func ParseInt(value string) *int64 {
+	number, err := strconv.ParseInt(strings.ReplaceAll(strings.TrimSpace(value), ",", ""), 10, 64)
+	if err != nil {
+		return nil
+	}
 
-	nPrevious := page - 1
-	previousPage := &nPrevious
+	return &number
+}
 
-	if *previousPage < 0 {
-		previousPage = nil
+// ParseFloat is ParseInt for numbers with decimals..
+func ParseFloat(value string) *float64 {
+	number, err := strconv.ParseFloat(strings.ReplaceAll(strings.TrimSpace(value), ",", ""), 64)
+	if err != nil {
+		return nil
 	}
 
-	return nextPage, previousPage
+	return &number
 }
@@ -0,0 +1,224 @@
+package generic
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestParseInt(t *testing.T) {
+	var population int64 = 1000000000000
+	var diameter int64 = 12120
+	cases := map[string]struct {
+		input    string
+		expected *int64
+	}{
+		"should parse a number":                     {input: "1000000000000", expected: &population},
+		"should parse a number with separators":     {input: "12,120", expected: &diameter},
+		"should return nil when unknown":            {input: "unknown", expected: nil},
+		"should return nil when it is not a number": {input: "N/A", expected: nil},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, cs.expected, ParseInt(cs.input))
+		})
+	}
+}
+
+func TestParseFloat(t *testing.T) {
+	surfaceWater := 0.9
+	cases := map[string]struct {
+		input    string
+		expected *float64
+	}{
+		"should parse a number":          {input: "0.9", expected: &surfaceWater},
+		"should return nil when unknown": {input: "unknown", expected: nil},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, cs.expected, ParseFloat(cs.input))
+		})
+	}
+}
+
+func TestNewPagination(t *testing.T) {
+	nextPage := int64(3)
+	previousPage := int64(1)
+	lastPreviousPage := int64(2)
+	cases := map[string]struct {
+		page     int64
+		limit    int64
+		count    int64
+		expected Pagination
+	}{
+		"should have next and previous pages in the middle": {
+			page: 2, limit: 10, count: 25,
+			expected: Pagination{Count: 25, NextPage: &nextPage, PreviousPage: &previousPage, Page: 2, Limit: 10, TotalPages: 3},
+		},
+		"should not have previous page in the first page": {
+			page: 1, limit: 10, count: 10,
+			expected: Pagination{Count: 10, Page: 1, Limit: 10, TotalPages: 1},
+		},
+		"should not have next page in the last page": {
+			page: 3, limit: 10, count: 21,
+			expected: Pagination{Count: 21, PreviousPage: &lastPreviousPage, Page: 3, Limit: 10, TotalPages: 3},
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, cs.expected, NewPagination(cs.page, cs.limit, cs.count))
+		})
+	}
+}
+
+func TestParseLimit(t *testing.T) {
+	cases := map[string]struct {
+		input       string
+		expected    int64
+		expectedErr bool
+	}{
+		"should use the default limit when empty":  {input: "", expected: DefaultLimit},
+		"should parse the limit":                   {input: "25", expected: 25},
+		"should reduce the limit to the max":       {input: "1000", expected: MaxLimit},
+		"should throw error when zero":             {input: "0", expectedErr: true},
+		"should throw error when it is not number": {input: "xpto", expectedErr: true},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			limit, err := ParseLimit(cs.input)
+			assert.Equal(t, cs.expectedErr, err != nil)
+			assert.Equal(t, cs.expected, limit)
+		})
+	}
+}
+
+func TestParsePage(t *testing.T) {
+	cases := map[string]struct {
+		input       string
+		expected    int64
+		expectedErr bool
+	}{
+		"should start at the first page when empty": {input: "", expected: 1},
+		"should parse the page":                     {input: "3", expected: 3},
+		"should throw error when negative":          {input: "-1", expectedErr: true},
+		"should throw error when it is not number":  {input: "xpto", expectedErr: true},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			page, err := ParsePage(cs.input)
+			assert.Equal(t, cs.expectedErr, err != nil)
+			assert.Equal(t, cs.expected, page)
+		})
+	}
+}
+
+func TestOffset(t *testing.T) {
+	assert.Equal(t, int64(0), Offset(1, 10))
+	assert.Equal(t, int64(20), Offset(3, 10))
+}
+
+func TestDecodeCursor(t *testing.T) {
+	cursor := Cursor{Keys: []string{"4"}, ID: 1, Backward: true}
+	cases := map[string]struct {
+		input       string
+		expected    *Cursor
+		expectedErr error
+	}{
+		"should decode an encoded cursor":             {input: cursor.Encode(), expected: &cursor},
+		"should throw error when it is not base64":    {input: "***", expectedErr: ErrorInvalidCursor},
+		"should throw error when it is not json":      {input: "eHB0bw", expectedErr: ErrorInvalidCursor},
+		"should throw error when it does not have id": {input: Cursor{Keys: []string{"4"}}.Encode(), expectedErr: ErrorInvalidCursor},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			cursor, err := DecodeCursor(cs.input)
+			assert.Equal(t, cs.expectedErr, err)
+			assert.Equal(t, cs.expected, cursor)
+		})
+	}
+}
+
+func TestNewCursorPagination(t *testing.T) {
+	first := Cursor{ID: 1}
+	last := Cursor{ID: 10}
+	next := Cursor{ID: 10}.Encode()
+	prev := Cursor{ID: 1, Backward: true}.Encode()
+	cases := map[string]struct {
+		cursor   *Cursor
+		more     bool
+		expected CursorPagination
+	}{
+		"should have only next cursor in the first page": {
+			cursor:   nil,
+			more:     true,
+			expected: CursorPagination{Limit: 10, NextCursor: &next},
+		},
+		"should have both cursors in the middle": {
+			cursor:   &Cursor{ID: 1},
+			more:     true,
+			expected: CursorPagination{Limit: 10, NextCursor: &next, PrevCursor: &prev},
+		},
+		"should have only prev cursor in the last page": {
+			cursor:   &Cursor{ID: 1},
+			more:     false,
+			expected: CursorPagination{Limit: 10, PrevCursor: &prev},
+		},
+		"should have only next cursor going back to the first page": {
+			cursor:   &Cursor{ID: 11, Backward: true},
+			more:     false,
+			expected: CursorPagination{Limit: 10, NextCursor: &next},
+		},
+		"should have both cursors going back to the middle": {
+			cursor:   &Cursor{ID: 21, Backward: true},
+			more:     true,
+			expected: CursorPagination{Limit: 10, NextCursor: &next, PrevCursor: &prev},
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, cs.expected, NewCursorPagination(cs.cursor, 10, cs.more, first, last))
+		})
+	}
+}
+
+func TestWithCursors(t *testing.T) {
+	next := Cursor{ID: 20}.Encode()
+	prev := Cursor{ID: 11, Backward: true}.Encode()
+
+	pagination := NewPagination(2, 10, 30).WithCursors(Cursor{ID: 11}, Cursor{ID: 20})
+
+	assert.Equal(t, &next, pagination.NextCursor)
+	assert.Equal(t, &prev, pagination.PrevCursor)
+}
+
+func TestPaginationJSON(t *testing.T) {
+	cases := map[string]struct {
+		pagination interface{}
+		expected   string
+	}{
+		"should always have the pages when reading by page": {
+			pagination: NewPagination(1, 10, 0),
+			expected:   `{"count":0,"next_page":null,"previous_page":null,"page":1,"limit":10,"total_pages":0}`,
+		},
+		"should have only the cursors when reading by cursor": {
+			pagination: NewCursorPagination(nil, 10, false, Cursor{ID: 1}, Cursor{ID: 1}),
+			expected:   `{"count":0,"limit":10,"next_cursor":null,"prev_cursor":null}`,
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			data, err := json.Marshal(cs.pagination)
+			assert.Equal(t, nil, err)
+			assert.Equal(t, cs.expected, string(data))
+		})
+	}
+}
@@ -0,0 +1,134 @@
+package importer
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultWorkers is the pool size used when none is configured.
+const DefaultWorkers = 5
+
+type Options struct {
+	Workers int
+	// Incremental updates the records edited on SWAPI after the last import,
+	// otherwise only the records never seen are created.
+	Incremental bool
+	// DryRun compares SWAPI with the database without writing anything.
+	DryRun bool
+}
+
+type Action string
+
+const (
+	ActionCreated   Action = "created"
+	ActionUpdated   Action = "updated"
+	ActionUnchanged Action = "unchanged"
+	ActionLinked    Action = "linked"
+	// ActionSkipped is a planet soft deleted on the api, kept in the trash instead of imported again.
+	ActionSkipped Action = "skipped"
+)
+
+type Resource string
+
+const (
+	ResourcePlanet       Resource = "planet"
+	ResourceFilm         Resource = "film"
+	ResourceFilmPlanet   Resource = "film_planet"
+	ResourcePeople       Resource = "people"
+	ResourceFilmPeople   Resource = "film_people"
+	ResourceStarship     Resource = "starship"
+	ResourceFilmStarship Resource = "film_starship"
+	ResourceVehicle      Resource = "vehicle"
+	ResourceFilmVehicle  Resource = "film_vehicle"
+	ResourceSpecies      Resource = "species"
+	ResourceFilmSpecies  Resource = "film_species"
+)
+
+type Counts struct {
+	Created   int `json:"created"`
+	Updated   int `json:"updated"`
+	Unchanged int `json:"unchanged"`
+	Skipped   int `json:"skipped"`
+}
+
+// Add count one more record with the action..
+func (c *Counts) Add(action Action) {
+	switch action {
+	case ActionCreated:
+		c.Created++
+	case ActionUpdated:
+		c.Updated++
+	case ActionUnchanged:
+		c.Unchanged++
+	case ActionSkipped:
+		c.Skipped++
+	}
+}
+
+type Change struct {
+	Resource Resource `json:"resource"`
+	Action   Action   `json:"action"`
+	Name     string   `json:"name"`
+}
+
+type Report struct {
+	DryRun    bool     `json:"dry_run"`
+	Planets   Counts   `json:"planets"`
+	Films     Counts   `json:"films"`
+	People    Counts   `json:"people"`
+	Starships Counts   `json:"starships"`
+	Vehicles  Counts   `json:"vehicles"`
+	Species   Counts   `json:"species"`
+	Links     int      `json:"links"`
+	Changes   []Change `json:"changes"`
+}
+
+// Record count the action on the resource, keeping in Changes everything but the unchanged records..
+func (r *Report) Record(resource Resource, action Action, name string) {
+	switch resource {
+	case ResourcePlanet:
+		r.Planets.Add(action)
+	case ResourceFilm:
+		r.Films.Add(action)
+	case ResourcePeople:
+		r.People.Add(action)
+	case ResourceStarship:
+		r.Starships.Add(action)
+	case ResourceVehicle:
+		r.Vehicles.Add(action)
+	case ResourceSpecies:
+		r.Species.Add(action)
+	case ResourceFilmPlanet, ResourceFilmPeople, ResourceFilmStarship, ResourceFilmVehicle, ResourceFilmSpecies:
+		r.Links++
+	}
+
+	if action != ActionUnchanged {
+		r.Changes = append(r.Changes, Change{
+			Resource: resource,
+			Action:   action,
+			Name:     name,
+		})
+	}
+}
+
+// Text render the report to be read on a terminal..
+func (r *Report) Text() string {
+	var text strings.Builder
+	if r.DryRun {
+		text.WriteString("Dry run, nothing was written to the database.\n")
+	}
+
+	fmt.Fprintf(&text, "Planets: %d created, %d updated, %d unchanged, %d skipped\n", r.Planets.Created, r.Planets.Updated, r.Planets.Unchanged, r.Planets.Skipped)
+	fmt.Fprintf(&text, "Films: %d created, %d updated, %d unchanged\n", r.Films.Created, r.Films.Updated, r.Films.Unchanged)
+	fmt.Fprintf(&text, "People: %d created, %d updated, %d unchanged\n", r.People.Created, r.People.Updated, r.People.Unchanged)
+	fmt.Fprintf(&text, "Starships: %d created, %d updated, %d unchanged\n", r.Starships.Created, r.Starships.Updated, r.Starships.Unchanged)
+	fmt.Fprintf(&text, "Vehicles: %d created, %d updated, %d unchanged\n", r.Vehicles.Created, r.Vehicles.Updated, r.Vehicles.Unchanged)
+	fmt.Fprintf(&text, "Species: %d created, %d updated, %d unchanged\n", r.Species.Created, r.Species.Updated, r.Species.Unchanged)
+	fmt.Fprintf(&text, "Links: %d created\n", r.Links)
+
+	for _, change := range r.Changes {
+		fmt.Fprintf(&text, "  %-9s %-11s %s\n", change.Action, change.Resource, change.Name)
+	}
+
+	return text.String()
+}
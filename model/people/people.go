@@ -0,0 +1,62 @@
+package people
+
+import (
+	"errors"
+	"time"
+
+	filmModel "github.com/danilotadeu/star_wars/model/film"
+	genericModel "github.com/danilotadeu/star_wars/model/generic"
+)
+
+var ErrorPeopleNotFound = errors.New("People not found")
+
+type ResultPeople struct {
+	Name      string    `json:"name"`
+	Height    string    `json:"height"`
+	Mass      string    `json:"mass"`
+	HairColor string    `json:"hair_color"`
+	SkinColor string    `json:"skin_color"`
+	EyeColor  string    `json:"eye_color"`
+	BirthYear string    `json:"birth_year"`
+	Gender    string    `json:"gender"`
+	Homeworld string    `json:"homeworld"`
+	Films     []string  `json:"films"`
+	Species   []string  `json:"species"`
+	Vehicles  []string  `json:"vehicles"`
+	Starships []string  `json:"starships"`
+	Created   time.Time `json:"created"`
+	Edited    time.Time `json:"edited"`
+	URL       string    `json:"url"`
+}
+
+type PeopleDB struct {
+	ID        int64            `json:"id"`
+	Name      string           `json:"name"`
+	Height    string           `json:"height"`
+	Mass      string           `json:"mass"`
+	HairColor string           `json:"hair_color"`
+	SkinColor string           `json:"skin_color"`
+	EyeColor  string           `json:"eye_color"`
+	BirthYear string           `json:"birth_year"`
+	Gender    string           `json:"gender"`
+	PlanetID  *int64           `json:"planet_id"`
+	CreatedAt time.Time        `json:"created_at"`
+	EditedAt  *time.Time       `json:"edited_at,omitempty"`
+	Films     []filmModel.Film `json:"films,omitempty"`
+}
+
+type FilmPeople struct {
+	FilmID    int64     `json:"film_id"`
+	PeopleID  int64     `json:"people_id"`
+	CreatedAt time.Time `json:"created_at"`
+	Film      filmModel.Film
+}
+
+type PeopleTotal struct {
+	Total int64 `json:"total"`
+}
+
+type ResponsePeople struct {
+	Data               []*PeopleDB             `json:"data"`
+	ResponsePagination genericModel.Pagination `json:"pagination"`
+}
@@ -1,7 +1,12 @@
 package planet
 
 import (
+	"encoding/json"
 	"errors"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	filmModel "github.com/danilotadeu/star_wars/model/film"
@@ -9,6 +14,8 @@ import (
 )
 
 var ErrorPlanetNotFound = errors.New("Planet not found")
+var ErrorInvalidRange = errors.New("Invalid range filter")
+var ErrorPlanetAlreadyExists = errors.New("Planet already exists")
 
 type ResultPlanet struct {
 	Count    int         `json:"count"`
@@ -35,13 +42,74 @@ type Planet struct {
 }
 
 type PlanetDB struct {
-	ID        int64            `json:"id"`
-	Name      string           `json:"name"`
-	Climate   string           `json:"climate"`
-	Terrain   string           `json:"terrain"`
-	CreatedAt time.Time        `json:"created_at"`
-	DeletedAt *time.Time       `json:"deleted_at,omitempty"`
-	Films     []filmModel.Film `json:"films,omitempty"`
+	ID             int64            `json:"id"`
+	Name           string           `json:"name"`
+	Climate        string           `json:"climate"`
+	Terrain        string           `json:"terrain"`
+	RotationPeriod *int64           `json:"rotation_period"`
+	OrbitalPeriod  *int64           `json:"orbital_period"`
+	Diameter       *int64           `json:"diameter"`
+	Gravity        string           `json:"gravity"`
+	SurfaceWater   *float64         `json:"surface_water"`
+	Population     *int64           `json:"population"`
+	CreatedAt      time.Time        `json:"created_at"`
+	DeletedAt      *time.Time       `json:"deleted_at,omitempty"`
+	EditedAt       *time.Time       `json:"edited_at,omitempty"`
+	Films          []filmModel.Film `json:"films,omitempty"`
+}
+
+// Cursor is the position of the planet on the keyset pagination of the listings ordered by sorts..
+func (p *PlanetDB) Cursor(sorts []Sort) genericModel.Cursor {
+	var keys []string
+	for _, order := range sorts {
+		keys = append(keys, p.sortKey(order.Field))
+	}
+
+	return genericModel.Cursor{
+		Sort: FormatSort(sorts),
+		Keys: keys,
+		ID:   p.ID,
+	}
+}
+
+// sortKey is the value of the planet on one of SortFields as the store compares it:
+// the unknown numbers as -1, so they come first, and film_count as the films of the listing..
+func (p *PlanetDB) sortKey(field string) string {
+	switch field {
+	case "name":
+		return p.Name
+	case "climate":
+		return p.Climate
+	case "terrain":
+		return p.Terrain
+	case "rotation_period":
+		return intSortKey(p.RotationPeriod)
+	case "orbital_period":
+		return intSortKey(p.OrbitalPeriod)
+	case "diameter":
+		return intSortKey(p.Diameter)
+	case "surface_water":
+		if p.SurfaceWater == nil {
+			return "-1"
+		}
+		return strconv.FormatFloat(*p.SurfaceWater, 'f', -1, 64)
+	case "population":
+		return intSortKey(p.Population)
+	case "created_at":
+		return p.CreatedAt.Format("2006-01-02 15:04:05")
+	case "film_count":
+		return strconv.Itoa(len(p.Films))
+	}
+
+	return ""
+}
+
+func intSortKey(value *int64) string {
+	if value == nil {
+		return "-1"
+	}
+
+	return strconv.FormatInt(*value, 10)
 }
 
 type PlanetsTotal struct {
@@ -52,3 +120,337 @@ type ResponsePlanets struct {
 	Data               []*PlanetDB             `json:"data"`
 	ResponsePagination genericModel.Pagination `json:"pagination"`
 }
+
+// ResponsePlanetsByCursor is a page of the listing read by cursor..
+type ResponsePlanetsByCursor struct {
+	Data               []*PlanetDB                   `json:"data"`
+	ResponsePagination genericModel.CursorPagination `json:"pagination"`
+}
+
+// RangeFields are the numeric attributes of the planet accepting range filters..
+var RangeFields = []string{"rotation_period", "orbital_period", "diameter", "surface_water", "population"}
+
+const (
+	OperatorGreater        = "gt"
+	OperatorGreaterOrEqual = "gte"
+	OperatorLess           = "lt"
+	OperatorLessOrEqual    = "lte"
+	OperatorBetween        = "between"
+)
+
+// RangeOperators map the suffix of a range filter to its SQL operator..
+var RangeOperators = map[string]string{
+	OperatorGreater:        ">",
+	OperatorGreaterOrEqual: ">=",
+	OperatorLess:           "<",
+	OperatorLessOrEqual:    "<=",
+	OperatorBetween:        "BETWEEN",
+}
+
+// Filter is the search on the list of planets..
+type Filter struct {
+	Name string
+	// Climates and Terrains are tokens the planet must have all of, as in "temperate, tropical"..
+	Climates []string
+	Terrains []string
+	// FilmID keeps the planets linked to the film, any film when 0..
+	FilmID int64
+	Ranges []Range
+	// Sort is the ordering of the listing, only by id when empty. It does not change the matched planets..
+	Sort []Sort
+}
+
+// ParseTokens read a comma separated param, like climate=arid,temperate, as the tokens to match..
+func ParseTokens(value string) []string {
+	var tokens []string
+	for _, token := range strings.Split(value, ",") {
+		token = strings.TrimSpace(token)
+		if len(token) > 0 {
+			tokens = append(tokens, token)
+		}
+	}
+
+	return tokens
+}
+
+// SortFields are the fields accepted by the sort param..
+var SortFields = []string{"name", "climate", "terrain", "rotation_period", "orbital_period", "diameter", "surface_water", "population", "created_at", "film_count"}
+
+// Sort orders the planet listings by one of SortFields, descending when Desc..
+type Sort struct {
+	Field string
+	Desc  bool
+}
+
+// InvalidSortError is returned by ParseSort with the field that can not be used..
+type InvalidSortError struct {
+	Field string
+}
+
+func (e *InvalidSortError) Error() string {
+	return "Invalid planet sort " + e.Field
+}
+
+// ParseSort read the sort param, like "name,-created_at", where a leading - is the descending order.
+// It returns an InvalidSortError when a field is not one of SortFields or is repeated..
+func ParseSort(value string) ([]Sort, error) {
+	if len(value) == 0 {
+		return nil, nil
+	}
+
+	parts := strings.Split(value, ",")
+	sorts := make([]Sort, 0, len(parts))
+	seen := make(map[string]bool, len(parts))
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		order := Sort{
+			Field: strings.TrimPrefix(part, "-"),
+			Desc:  strings.HasPrefix(part, "-"),
+		}
+
+		if !isSortField(order.Field) || seen[order.Field] {
+			return nil, &InvalidSortError{Field: part}
+		}
+		seen[order.Field] = true
+
+		sorts = append(sorts, order)
+	}
+
+	return sorts, nil
+}
+
+// FormatSort write sorts as the sort param read by ParseSort..
+func FormatSort(sorts []Sort) string {
+	parts := make([]string, 0, len(sorts))
+	for _, order := range sorts {
+		if order.Desc {
+			parts = append(parts, "-"+order.Field)
+		} else {
+			parts = append(parts, order.Field)
+		}
+	}
+
+	return strings.Join(parts, ",")
+}
+
+func isSortField(field string) bool {
+	for _, sortField := range SortFields {
+		if sortField == field {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Range is a filter like population_gte=1000 or diameter_between=1000,5000 on one of RangeFields..
+type Range struct {
+	Field    string
+	Operator string
+	Values   []float64
+}
+
+// ParseRange read the query parameter param as a range filter.
+// It returns nil when param is not a range filter and ErrorInvalidRange when the value is not valid for the operator..
+func ParseRange(param, value string) (*Range, error) {
+	idx := strings.LastIndex(param, "_")
+	if idx < 0 {
+		return nil, nil
+	}
+
+	field, operator := param[:idx], param[idx+1:]
+	if _, ok := RangeOperators[operator]; !ok || !isRangeField(field) {
+		return nil, nil
+	}
+
+	parts := strings.Split(value, ",")
+	if (operator == OperatorBetween) != (len(parts) == 2) {
+		return nil, ErrorInvalidRange
+	}
+
+	values := make([]float64, len(parts))
+	for i, part := range parts {
+		number, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil {
+			return nil, ErrorInvalidRange
+		}
+		values[i] = number
+	}
+
+	return &Range{
+		Field:    field,
+		Operator: operator,
+		Values:   values,
+	}, nil
+}
+
+func isRangeField(field string) bool {
+	for _, rangeField := range RangeFields {
+		if rangeField == field {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PlanetRequest is the body to create or replace a planet..
+type PlanetRequest struct {
+	Name           string   `json:"name"`
+	Climate        string   `json:"climate"`
+	Terrain        string   `json:"terrain"`
+	RotationPeriod *int64   `json:"rotation_period"`
+	OrbitalPeriod  *int64   `json:"orbital_period"`
+	Diameter       *int64   `json:"diameter"`
+	Gravity        string   `json:"gravity"`
+	SurfaceWater   *float64 `json:"surface_water"`
+	Population     *int64   `json:"population"`
+	FilmIDs        []int64  `json:"film_ids"`
+}
+
+// InvalidFieldError is returned by PlanetRequest.Validate with the json name of the invalid field..
+type InvalidFieldError struct {
+	Field string
+}
+
+func (e *InvalidFieldError) Error() string {
+	return "Invalid planet field " + e.Field
+}
+
+// maxTextLength is the size of the VARCHAR columns of the planet..
+const maxTextLength = 45
+
+// Validate check the planet against the constraints of the planet table..
+func (p PlanetRequest) Validate() error {
+	texts := []struct {
+		field    string
+		value    string
+		required bool
+	}{
+		{field: "name", value: p.Name, required: true},
+		{field: "climate", value: p.Climate, required: true},
+		{field: "terrain", value: p.Terrain, required: true},
+		{field: "gravity", value: p.Gravity},
+	}
+	for _, text := range texts {
+		if (text.required && len(strings.TrimSpace(text.value)) == 0) || len([]rune(text.value)) > maxTextLength {
+			return &InvalidFieldError{Field: text.field}
+		}
+	}
+
+	numbers := []struct {
+		field string
+		value *int64
+		max   int64
+	}{
+		{field: "rotation_period", value: p.RotationPeriod, max: math.MaxInt32},
+		{field: "orbital_period", value: p.OrbitalPeriod, max: math.MaxInt32},
+		{field: "diameter", value: p.Diameter, max: math.MaxInt32},
+		{field: "population", value: p.Population, max: math.MaxInt64},
+	}
+	for _, number := range numbers {
+		if number.value != nil && (*number.value < 0 || *number.value > number.max) {
+			return &InvalidFieldError{Field: number.field}
+		}
+	}
+
+	if p.SurfaceWater != nil && (*p.SurfaceWater < 0 || *p.SurfaceWater > 100) {
+		return &InvalidFieldError{Field: "surface_water"}
+	}
+
+	for _, filmID := range p.FilmIDs {
+		if filmID <= 0 {
+			return &InvalidFieldError{Field: "film_ids"}
+		}
+	}
+
+	return nil
+}
+
+// Request return the attributes of the planet as a PlanetRequest, without the films..
+func (p PlanetDB) Request() PlanetRequest {
+	return PlanetRequest{
+		Name:           p.Name,
+		Climate:        p.Climate,
+		Terrain:        p.Terrain,
+		RotationPeriod: p.RotationPeriod,
+		OrbitalPeriod:  p.OrbitalPeriod,
+		Diameter:       p.Diameter,
+		Gravity:        p.Gravity,
+		SurfaceWater:   p.SurfaceWater,
+		Population:     p.Population,
+	}
+}
+
+// PlanetPatch is a JSON Merge Patch (RFC 7396) of a PlanetRequest: absent fields are kept and null removes the value..
+type PlanetPatch map[string]json.RawMessage
+
+// HasFilms tell if the patch replaces the film links..
+func (p PlanetPatch) HasFilms() bool {
+	_, ok := p["film_ids"]
+	return ok
+}
+
+// Apply merge the patch into planet and validate the result..
+func (p PlanetPatch) Apply(planet PlanetRequest) (PlanetRequest, error) {
+	current, err := json.Marshal(planet)
+	if err != nil {
+		return PlanetRequest{}, err
+	}
+
+	merged := map[string]json.RawMessage{}
+	err = json.Unmarshal(current, &merged)
+	if err != nil {
+		return PlanetRequest{}, err
+	}
+
+	fields := make([]string, 0, len(p))
+	for field := range p {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		if _, ok := merged[field]; !ok {
+			return PlanetRequest{}, &InvalidFieldError{Field: field}
+		}
+
+		if value := p[field]; string(value) == "null" {
+			delete(merged, field)
+		} else {
+			merged[field] = value
+		}
+	}
+
+	body, err := json.Marshal(merged)
+	if err != nil {
+		return PlanetRequest{}, err
+	}
+
+	var patched PlanetRequest
+	err = json.Unmarshal(body, &patched)
+	if err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) {
+			return PlanetRequest{}, &InvalidFieldError{Field: typeErr.Field}
+		}
+		return PlanetRequest{}, err
+	}
+
+	err = patched.Validate()
+	if err != nil {
+		return PlanetRequest{}, err
+	}
+
+	return patched, nil
+}
+
+// DefaultPurgeRetention is how long a soft deleted planet is kept before the purge deletes it..
+const DefaultPurgeRetention = 30 * 24 * time.Hour
+
+// PurgeReport count the rows removed by a purge..
+type PurgeReport struct {
+	Planets    int64 `json:"planets"`
+	FilmLinks  int64 `json:"film_links"`
+	Homeworlds int64 `json:"homeworlds"`
+}
@@ -0,0 +1,263 @@
+package planet
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	filmModel "github.com/danilotadeu/star_wars/model/film"
+	genericModel "github.com/danilotadeu/star_wars/model/generic"
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestParseRange(t *testing.T) {
+	cases := map[string]struct {
+		param       string
+		value       string
+		expected    *Range
+		expectedErr error
+	}{
+		"should parse a greater or equal filter": {
+			param:    "population_gte",
+			value:    "1000",
+			expected: &Range{Field: "population", Operator: OperatorGreaterOrEqual, Values: []float64{1000}},
+		},
+		"should parse a between filter": {
+			param:    "orbital_period_between",
+			value:    "300, 400",
+			expected: &Range{Field: "orbital_period", Operator: OperatorBetween, Values: []float64{300, 400}},
+		},
+		"should ignore a parameter that is not a range filter": {
+			param: "name",
+			value: "Tatooine",
+		},
+		"should ignore a field without range filter": {
+			param: "gravity_gte",
+			value: "1",
+		},
+		"should throw error with a value that is not a number": {
+			param:       "diameter_lt",
+			value:       "xpto",
+			expectedErr: ErrorInvalidRange,
+		},
+		"should throw error with between without two values": {
+			param:       "diameter_between",
+			value:       "1000",
+			expectedErr: ErrorInvalidRange,
+		},
+		"should throw error with two values without between": {
+			param:       "diameter_gt",
+			value:       "1000,2000",
+			expectedErr: ErrorInvalidRange,
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			rangeFilter, err := ParseRange(cs.param, cs.value)
+
+			assert.Equal(t, cs.expectedErr, err)
+			assert.Equal(t, cs.expected, rangeFilter)
+		})
+	}
+}
+
+func TestParseTokens(t *testing.T) {
+	cases := map[string]struct {
+		value    string
+		expected []string
+	}{
+		"should parse a token":             {value: "arid", expected: []string{"arid"}},
+		"should parse tokens with spaces":  {value: "temperate, grassy hills", expected: []string{"temperate", "grassy hills"}},
+		"should ignore empty tokens":       {value: "arid,,", expected: []string{"arid"}},
+		"should return nil without tokens": {value: "", expected: nil},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, cs.expected, ParseTokens(cs.value))
+		})
+	}
+}
+
+func TestParseSort(t *testing.T) {
+	cases := map[string]struct {
+		value       string
+		expected    []Sort
+		expectedErr error
+	}{
+		"should parse ascending and descending fields": {
+			value:    "name,-created_at",
+			expected: []Sort{{Field: "name"}, {Field: "created_at", Desc: true}},
+		},
+		"should parse a computed field": {
+			value:    "-film_count",
+			expected: []Sort{{Field: "film_count", Desc: true}},
+		},
+		"should return nil without sort": {
+			value:    "",
+			expected: nil,
+		},
+		"should throw error with a field out of the allowlist": {
+			value:       "name,-gravity",
+			expectedErr: &InvalidSortError{Field: "-gravity"},
+		},
+		"should throw error with a repeated field": {
+			value:       "name,-name",
+			expectedErr: &InvalidSortError{Field: "-name"},
+		},
+		"should throw error with an empty field": {
+			value:       "name,",
+			expectedErr: &InvalidSortError{Field: ""},
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			sorts, err := ParseSort(cs.value)
+			assert.Equal(t, cs.expectedErr, err)
+			assert.Equal(t, cs.expected, sorts)
+		})
+	}
+}
+
+func TestCursor(t *testing.T) {
+	var population int64 = 200000
+	createdAt := time.Date(2021, 11, 22, 10, 30, 0, 0, time.UTC)
+	planet := PlanetDB{
+		ID:         1,
+		Name:       "Tatooine",
+		Population: &population,
+		CreatedAt:  createdAt,
+		Films:      []filmModel.Film{{ID: 1}, {ID: 3}},
+	}
+	sorts := []Sort{{Field: "name"}, {Field: "population", Desc: true}, {Field: "diameter"}, {Field: "created_at"}, {Field: "film_count"}}
+
+	cursor := planet.Cursor(sorts)
+
+	assert.Equal(t, genericModel.Cursor{
+		Sort: "name,-population,diameter,created_at,film_count",
+		Keys: []string{"Tatooine", "200000", "-1", "2021-11-22 10:30:00", "2"},
+		ID:   1,
+	}, cursor)
+	assert.Equal(t, genericModel.Cursor{ID: 1}, planet.Cursor(nil))
+}
+
+func TestValidate(t *testing.T) {
+	var negative int64 = -1
+	var tooBig int64 = 1 << 40
+	surfaceWater := 101.0
+	cases := map[string]struct {
+		input       PlanetRequest
+		expectedErr error
+	}{
+		"should accept a valid planet": {
+			input: PlanetRequest{Name: "Ilum", Climate: "frozen", Terrain: "glaciers", FilmIDs: []int64{1}},
+		},
+		"should throw error without name": {
+			input:       PlanetRequest{Name: " ", Climate: "frozen", Terrain: "glaciers"},
+			expectedErr: &InvalidFieldError{Field: "name"},
+		},
+		"should throw error with a name longer than the column": {
+			input:       PlanetRequest{Name: strings.Repeat("a", 46), Climate: "frozen", Terrain: "glaciers"},
+			expectedErr: &InvalidFieldError{Field: "name"},
+		},
+		"should throw error without terrain": {
+			input:       PlanetRequest{Name: "Ilum", Climate: "frozen"},
+			expectedErr: &InvalidFieldError{Field: "terrain"},
+		},
+		"should throw error with a negative diameter": {
+			input:       PlanetRequest{Name: "Ilum", Climate: "frozen", Terrain: "glaciers", Diameter: &negative},
+			expectedErr: &InvalidFieldError{Field: "diameter"},
+		},
+		"should throw error with a rotation period bigger than the column": {
+			input:       PlanetRequest{Name: "Ilum", Climate: "frozen", Terrain: "glaciers", RotationPeriod: &tooBig},
+			expectedErr: &InvalidFieldError{Field: "rotation_period"},
+		},
+		"should throw error with surface water over 100": {
+			input:       PlanetRequest{Name: "Ilum", Climate: "frozen", Terrain: "glaciers", SurfaceWater: &surfaceWater},
+			expectedErr: &InvalidFieldError{Field: "surface_water"},
+		},
+		"should throw error with an invalid film id": {
+			input:       PlanetRequest{Name: "Ilum", Climate: "frozen", Terrain: "glaciers", FilmIDs: []int64{0}},
+			expectedErr: &InvalidFieldError{Field: "film_ids"},
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := cs.input.Validate()
+
+			assert.Equal(t, cs.expectedErr, err)
+		})
+	}
+}
+
+func TestApply(t *testing.T) {
+	var population int64 = 200000
+	var diameter int64 = 10465
+	current := PlanetRequest{
+		Name:       "Tatooine",
+		Climate:    "arid",
+		Terrain:    "desert",
+		Diameter:   &diameter,
+		Population: &population,
+	}
+	cases := map[string]struct {
+		input       string
+		expected    PlanetRequest
+		expectedErr error
+	}{
+		"should keep the absent fields": {
+			input: `{"climate": "temperate"}`,
+			expected: PlanetRequest{
+				Name:       "Tatooine",
+				Climate:    "temperate",
+				Terrain:    "desert",
+				Diameter:   &diameter,
+				Population: &population,
+			},
+		},
+		"should remove a value with null": {
+			input: `{"population": null, "film_ids": [1, 2]}`,
+			expected: PlanetRequest{
+				Name:     "Tatooine",
+				Climate:  "arid",
+				Terrain:  "desert",
+				Diameter: &diameter,
+				FilmIDs:  []int64{1, 2},
+			},
+		},
+		"should throw error when remove a required field": {
+			input:       `{"name": null}`,
+			expected:    PlanetRequest{},
+			expectedErr: &InvalidFieldError{Field: "name"},
+		},
+		"should throw error with an unknown field": {
+			input:       `{"residents": []}`,
+			expected:    PlanetRequest{},
+			expectedErr: &InvalidFieldError{Field: "residents"},
+		},
+		"should throw error with a field of the wrong type": {
+			input:       `{"diameter": "big"}`,
+			expected:    PlanetRequest{},
+			expectedErr: &InvalidFieldError{Field: "diameter"},
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			var patch PlanetPatch
+			err := json.Unmarshal([]byte(cs.input), &patch)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			planet, err := patch.Apply(current)
+
+			assert.Equal(t, cs.expectedErr, err)
+			assert.Equal(t, cs.expected, planet)
+		})
+	}
+}
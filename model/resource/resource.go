@@ -0,0 +1,32 @@
+package resource
+
+import (
+	"time"
+
+	filmModel "github.com/danilotadeu/star_wars/model/film"
+	genericModel "github.com/danilotadeu/star_wars/model/generic"
+)
+
+// Record is a row of one of the SWAPI resources listed with their films, like starships..
+type Record interface {
+	// RecordID is the id of the row..
+	RecordID() int64
+	// RecordEdited is the SWAPI edited timestamp of the last import of the row..
+	RecordEdited() *time.Time
+	// AddFilm append a film the resource appears in..
+	AddFilm(film filmModel.Film)
+}
+
+// FilmLink is the relation between a resource and a film it appears in..
+type FilmLink struct {
+	ResourceID int64
+	FilmID     int64
+	CreatedAt  time.Time
+	Film       filmModel.Film
+}
+
+// Response is a page of a resource listing..
+type Response struct {
+	Data               []Record                `json:"data"`
+	ResponsePagination genericModel.Pagination `json:"pagination"`
+}
@@ -0,0 +1,62 @@
+package species
+
+import (
+	"errors"
+	"time"
+
+	filmModel "github.com/danilotadeu/star_wars/model/film"
+	genericModel "github.com/danilotadeu/star_wars/model/generic"
+)
+
+var ErrorSpeciesNotFound = errors.New("Species not found")
+
+type ResultSpecies struct {
+	Name            string    `json:"name"`
+	Classification  string    `json:"classification"`
+	Designation     string    `json:"designation"`
+	AverageHeight   string    `json:"average_height"`
+	SkinColors      string    `json:"skin_colors"`
+	HairColors      string    `json:"hair_colors"`
+	EyeColors       string    `json:"eye_colors"`
+	AverageLifespan string    `json:"average_lifespan"`
+	Language        string    `json:"language"`
+	Homeworld       *string   `json:"homeworld"`
+	People          []string  `json:"people"`
+	Films           []string  `json:"films"`
+	Created         time.Time `json:"created"`
+	Edited          time.Time `json:"edited"`
+	URL             string    `json:"url"`
+}
+
+type SpeciesDB struct {
+	ID              int64            `json:"id"`
+	Name            string           `json:"name"`
+	Classification  string           `json:"classification"`
+	Designation     string           `json:"designation"`
+	AverageHeight   string           `json:"average_height"`
+	SkinColors      string           `json:"skin_colors"`
+	HairColors      string           `json:"hair_colors"`
+	EyeColors       string           `json:"eye_colors"`
+	AverageLifespan string           `json:"average_lifespan"`
+	Language        string           `json:"language"`
+	CreatedAt       time.Time        `json:"created_at"`
+	EditedAt        *time.Time       `json:"edited_at,omitempty"`
+	Films           []filmModel.Film `json:"films,omitempty"`
+}
+
+func (s *SpeciesDB) RecordID() int64 {
+	return s.ID
+}
+
+func (s *SpeciesDB) RecordEdited() *time.Time {
+	return s.EditedAt
+}
+
+func (s *SpeciesDB) AddFilm(film filmModel.Film) {
+	s.Films = append(s.Films, film)
+}
+
+type ResponseSpecies struct {
+	Data               []*SpeciesDB            `json:"data"`
+	ResponsePagination genericModel.Pagination `json:"pagination"`
+}
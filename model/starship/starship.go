@@ -0,0 +1,69 @@
+package starship
+
+import (
+	"errors"
+	"time"
+
+	filmModel "github.com/danilotadeu/star_wars/model/film"
+	genericModel "github.com/danilotadeu/star_wars/model/generic"
+)
+
+var ErrorStarshipNotFound = errors.New("Starship not found")
+
+type ResultStarship struct {
+	Name                 string    `json:"name"`
+	Model                string    `json:"model"`
+	Manufacturer         string    `json:"manufacturer"`
+	CostInCredits        string    `json:"cost_in_credits"`
+	Length               string    `json:"length"`
+	MaxAtmospheringSpeed string    `json:"max_atmosphering_speed"`
+	Crew                 string    `json:"crew"`
+	Passengers           string    `json:"passengers"`
+	CargoCapacity        string    `json:"cargo_capacity"`
+	Consumables          string    `json:"consumables"`
+	HyperdriveRating     string    `json:"hyperdrive_rating"`
+	MGLT                 string    `json:"MGLT"`
+	StarshipClass        string    `json:"starship_class"`
+	Pilots               []string  `json:"pilots"`
+	Films                []string  `json:"films"`
+	Created              time.Time `json:"created"`
+	Edited               time.Time `json:"edited"`
+	URL                  string    `json:"url"`
+}
+
+type StarshipDB struct {
+	ID                   int64            `json:"id"`
+	Name                 string           `json:"name"`
+	Model                string           `json:"model"`
+	Manufacturer         string           `json:"manufacturer"`
+	CostInCredits        string           `json:"cost_in_credits"`
+	Length               string           `json:"length"`
+	MaxAtmospheringSpeed string           `json:"max_atmosphering_speed"`
+	Crew                 string           `json:"crew"`
+	Passengers           string           `json:"passengers"`
+	CargoCapacity        string           `json:"cargo_capacity"`
+	Consumables          string           `json:"consumables"`
+	HyperdriveRating     string           `json:"hyperdrive_rating"`
+	MGLT                 string           `json:"mglt"`
+	StarshipClass        string           `json:"starship_class"`
+	CreatedAt            time.Time        `json:"created_at"`
+	EditedAt             *time.Time       `json:"edited_at,omitempty"`
+	Films                []filmModel.Film `json:"films,omitempty"`
+}
+
+func (s *StarshipDB) RecordID() int64 {
+	return s.ID
+}
+
+func (s *StarshipDB) RecordEdited() *time.Time {
+	return s.EditedAt
+}
+
+func (s *StarshipDB) AddFilm(film filmModel.Film) {
+	s.Films = append(s.Films, film)
+}
+
+type ResponseStarships struct {
+	Data               []*StarshipDB           `json:"data"`
+	ResponsePagination genericModel.Pagination `json:"pagination"`
+}
@@ -0,0 +1,89 @@
+package swapi
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+var ErrorNotFound = errors.New("SWAPI resource not found")
+var ErrorRateLimited = errors.New("SWAPI rate limited")
+var ErrorServer = errors.New("SWAPI server error")
+var ErrorMalformedBody = errors.New("SWAPI malformed body")
+var ErrorUnexpectedStatus = errors.New("SWAPI unexpected status")
+var ErrorInvalidURL = errors.New("SWAPI invalid resource url")
+
+// UpstreamError is a failed call to SWAPI, Err is one of the errors above so it can be checked with errors.Is..
+type UpstreamError struct {
+	Err        error
+	URL        string
+	StatusCode int
+	RetryAfter time.Duration
+	Detail     string
+}
+
+func (e *UpstreamError) Error() string {
+	message := fmt.Sprintf("%s: %s (status %d)", e.Err.Error(), e.URL, e.StatusCode)
+	if len(e.Detail) > 0 {
+		message += ": " + e.Detail
+	}
+	return message
+}
+
+func (e *UpstreamError) Unwrap() error {
+	return e.Err
+}
+
+// NewStatusError classify a response of SWAPI that is not 200..
+func NewStatusError(url string, resp *http.Response) *UpstreamError {
+	upstreamErr := &UpstreamError{
+		Err:        ErrorUnexpectedStatus,
+		URL:        url,
+		StatusCode: resp.StatusCode,
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		upstreamErr.Err = ErrorNotFound
+	case resp.StatusCode == http.StatusTooManyRequests:
+		upstreamErr.Err = ErrorRateLimited
+		upstreamErr.RetryAfter, _ = ParseRetryAfter(resp.Header.Get("Retry-After"))
+	case resp.StatusCode >= http.StatusInternalServerError:
+		upstreamErr.Err = ErrorServer
+	}
+
+	return upstreamErr
+}
+
+// NewMalformedBodyError is a 200 response of SWAPI that could not be decoded..
+func NewMalformedBodyError(url string, statusCode int, err error) *UpstreamError {
+	return &UpstreamError{
+		Err:        ErrorMalformedBody,
+		URL:        url,
+		StatusCode: statusCode,
+		Detail:     err.Error(),
+	}
+}
+
+// ParseRetryAfter read the Retry-After header both as seconds and as an http date..
+func ParseRetryAfter(value string) (time.Duration, bool) {
+	if len(value) == 0 {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := time.Until(date)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
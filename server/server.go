@@ -1,16 +1,20 @@
 package server
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"io"
 	"log"
 	"os"
 	"os/signal"
+	"time"
 
 	"github.com/danilotadeu/star_wars/api"
 	"github.com/danilotadeu/star_wars/app"
+	planetModel "github.com/danilotadeu/star_wars/model/planet"
 	"github.com/danilotadeu/star_wars/store"
+	"github.com/danilotadeu/star_wars/store/swapi"
 	"github.com/sirupsen/logrus"
 	"gopkg.in/natefinch/lumberjack.v2"
 )
@@ -20,6 +24,7 @@ const LOGS_PATH = "log/logrus.log"
 // Server is a interface to define contract to server up
 type Server interface {
 	Start()
+	Purge(retention time.Duration)
 	ConnectDatabase() *sql.DB
 }
 
@@ -35,15 +40,29 @@ func New() Server {
 }
 
 func (e *server) Start() {
-	logrus.SetFormatter(&logrus.JSONFormatter{})
-	logrus.SetOutput(io.MultiWriter(os.Stdout, &lumberjack.Logger{
-		Filename: LOGS_PATH,
-		MaxSize:  50, // megabytes
-	}))
+	e.setupLog()
+
+	config, err := swapi.ConfigFromEnv()
+	if err != nil {
+		log.Fatalf("error swapi config: %s", err.Error())
+	}
 
 	e.Db = e.ConnectDatabase()
-	e.Store = store.Register(e.Db, os.Getenv("URL_STARWARS_API"))
+	e.Store = store.Register(e.Db, os.Getenv("URL_STARWARS_API"), swapi.NewClient(config))
 	e.App = app.Register(e.Store)
+
+	if interval := os.Getenv("PURGE_INTERVAL"); interval != "" {
+		every, err := time.ParseDuration(interval)
+		if err != nil || every <= 0 {
+			log.Fatalf("invalid PURGE_INTERVAL %q", interval)
+		}
+		retention, err := PurgeRetention()
+		if err != nil {
+			log.Fatal(err)
+		}
+		go e.schedulePurge(every, retention)
+	}
+
 	api.Register(e.App, os.Getenv("PORT"))
 
 	gracefulShutdown := make(chan os.Signal, 1)
@@ -54,6 +73,61 @@ func (e *server) Start() {
 	}()
 }
 
+// Purge run the purge of soft deleted planets once and close the database..
+func (e *server) Purge(retention time.Duration) {
+	e.setupLog()
+
+	config, err := swapi.ConfigFromEnv()
+	if err != nil {
+		log.Fatalf("error swapi config: %s", err.Error())
+	}
+
+	e.Db = e.ConnectDatabase()
+	defer e.Db.Close()
+	e.Store = store.Register(e.Db, os.Getenv("URL_STARWARS_API"), swapi.NewClient(config))
+	e.App = app.Register(e.Store)
+
+	if _, err := e.App.Planet.Purge(context.Background(), retention); err != nil {
+		log.Fatalf("error purge: %s", err.Error())
+	}
+}
+
+// schedulePurge run the purge when the api starts and then every interval while it is up..
+func (e *server) schedulePurge(interval, retention time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := e.App.Planet.Purge(context.Background(), retention); err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "server.schedulePurge.App.Planet.Purge"}).Error(err)
+		}
+		<-ticker.C
+	}
+}
+
+// PurgeRetention read the PURGE_RETENTION env, falling back to planetModel.DefaultPurgeRetention.
+// It fails when the env is not a duration or is negative..
+func PurgeRetention() (time.Duration, error) {
+	value := os.Getenv("PURGE_RETENTION")
+	if value == "" {
+		return planetModel.DefaultPurgeRetention, nil
+	}
+
+	retention, err := time.ParseDuration(value)
+	if err != nil || retention < 0 {
+		return 0, fmt.Errorf("invalid PURGE_RETENTION %q", value)
+	}
+	return retention, nil
+}
+
+func (e *server) setupLog() {
+	logrus.SetFormatter(&logrus.JSONFormatter{})
+	logrus.SetOutput(io.MultiWriter(os.Stdout, &lumberjack.Logger{
+		Filename: LOGS_PATH,
+		MaxSize:  50, // megabytes
+	}))
+}
+
 func (e *server) ConnectDatabase() *sql.DB {
 	connectionMysql := fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?multiStatements=true&parseTime=true", os.Getenv("DB_USER"), os.Getenv("DB_PASSWORD"), os.Getenv("DB_HOST"), os.Getenv("DB_PORT"), os.Getenv("DB_DATABASE"))
 	db, err := sql.Open("mysql", connectionMysql)
@@ -3,13 +3,15 @@ package film
 import (
 	"context"
 	"database/sql"
-	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
-	"strings"
+	"time"
 
 	filmModel "github.com/danilotadeu/star_wars/model/film"
+	genericModel "github.com/danilotadeu/star_wars/model/generic"
+	"github.com/danilotadeu/star_wars/store/keyset"
+	"github.com/danilotadeu/star_wars/store/swapi"
+	"github.com/danilotadeu/star_wars/store/transaction"
 	"github.com/jmoiron/sqlx"
 	"github.com/sirupsen/logrus"
 )
@@ -18,75 +20,82 @@ import (
 //
 //go:generate mockgen -destination ../../mock/store/film/film_store_mock.go -package mockStoreFilm . Store
 type Store interface {
-	GetFilms(ctx context.Context, films []string) ([]filmModel.ResultFilm, error)
+	WithTx(tx *sql.Tx) Store
+	GetFilm(ctx context.Context, film string) (*filmModel.ResultFilm, error)
 	SaveFilm(ctx context.Context, film filmModel.ResultFilm) (*int64, error)
+	UpdateFilm(ctx context.Context, id int64, film filmModel.ResultFilm) error
 	GetOne(ctx context.Context, name string) (*filmModel.Film, error)
+	GetOneByID(ctx context.Context, id int64) (*filmModel.Film, error)
+	GetAll(ctx context.Context, offset, limit int64, filter filmModel.Filter) ([]*filmModel.Film, error)
+	GetAllByCursor(ctx context.Context, cursor *genericModel.Cursor, limit int64, filter filmModel.Filter) ([]*filmModel.Film, error)
+	GetTotalFilms(ctx context.Context, filter filmModel.Filter) (*int64, error)
+	GetAllByPlanetID(ctx context.Context, planetID, offset, limit int64) ([]*filmModel.Film, error)
+	GetTotalFilmsByPlanetID(ctx context.Context, planetID int64) (*int64, error)
 	SaveFilmWithPlanet(ctx context.Context, planetID, filmID int64) (*int64, error)
+	DeleteFilmsWithPlanet(ctx context.Context, planetID int64) error
+	PurgeFilmsWithPlanet(ctx context.Context, deletedBefore time.Time) (int64, error)
 	GetFilmWithPlanet(ctx context.Context, planetID, filmID int64) (*filmModel.FilmPlanet, error)
 	GetFilmsByPlanetIDs(ctx context.Context, planetIDs []int64) ([]filmModel.FilmPlanet, error)
 }
 
+const filmColumns = "id, name, episode_id, opening_crawl, director, producer, release_date, created_at, edited_at"
+
+// filmKeyset is the ordering of the film listing, by episode with the films without one last,
+// matching filmModel.Film.Cursor..
+var filmKeyset = []keyset.Column{{Name: "episode_id IS NULL"}, {Name: "episode_id", Nullable: true}, {Name: "id"}}
+
 type storeImpl struct {
-	db          *sql.DB
+	db          transaction.Executor
+	client      *http.Client
 	urlStarWars string
 }
 
 // NewApp init a film
-func NewStore(db *sql.DB, urlStarWars string) Store {
+func NewStore(db *sql.DB, urlStarWars string, client *http.Client) Store {
 	return &storeImpl{
 		db:          db,
+		client:      client,
 		urlStarWars: urlStarWars,
 	}
 }
 
-// GetFilm get films in api star wars..
-func (a *storeImpl) GetFilms(ctx context.Context, films []string) ([]filmModel.ResultFilm, error) {
-	var resultsFilm []filmModel.ResultFilm
-	for _, film := range films {
-		id := strings.Split(film, a.urlStarWars+"/films/")[1]
-
-		client := &http.Client{}
-		url := a.urlStarWars + "/films/" + strings.TrimRight(id, "/")
-
-		req, err := http.NewRequest("GET", url, nil)
-		if err != nil {
-			logrus.WithFields(logrus.Fields{"trace": "store.film.GetFilms.newRequest"}).Error(err)
-			return nil, err
-		}
-		req.Header.Add("Accept", "application/json")
-		resp, err := client.Do(req)
-
-		if err != nil {
-			logrus.WithFields(logrus.Fields{"trace": "store.film.GetFilms.Do"}).Error(err)
-			return nil, err
-		}
-		defer resp.Body.Close()
-		respBody, err := io.ReadAll(resp.Body)
-		if err != nil {
-			logrus.WithFields(logrus.Fields{"trace": "store.film.GetFilms.readAll"}).Error(err)
-			return nil, err
-		}
+// WithTx return a copy of the store running the queries inside tx..
+func (a *storeImpl) WithTx(tx *sql.Tx) Store {
+	return &storeImpl{
+		db:          tx,
+		client:      a.client,
+		urlStarWars: a.urlStarWars,
+	}
+}
 
-		if resp.StatusCode == http.StatusOK {
-			var responseFilm filmModel.ResultFilm
-			err := json.Unmarshal(respBody, &responseFilm)
-			if err != nil {
-				logrus.WithFields(logrus.Fields{"trace": "store.film.GetFilms.jsonUnmarshal"}).Error(err)
-				return nil, err
-			}
+// GetFilm get a single film in api star wars..
+func (a *storeImpl) GetFilm(ctx context.Context, film string) (*filmModel.ResultFilm, error) {
+	url, err := swapi.ResourceURL(a.urlStarWars, "films", film)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.film.GetFilm.ResourceURL"}).Error(err)
+		return nil, err
+	}
 
-			resultsFilm = append(resultsFilm, responseFilm)
-		}
+	var responseFilm filmModel.ResultFilm
+	err = swapi.Get(ctx, a.client, url, &responseFilm)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.film.GetFilm.Get"}).Error(err)
+		return nil, err
 	}
 
-	return resultsFilm, nil
+	return &responseFilm, nil
 }
 
 func (a *storeImpl) SaveFilm(ctx context.Context, film filmModel.ResultFilm) (*int64, error) {
-	query := fmt.Sprintf("INSERT INTO film(name, director, release_date) VALUES ('%s','%s','%s')",
-		film.Title, film.Director, film.ReleaseDate)
-	res, err := a.db.Exec(query)
+	releaseDate, err := time.Parse(filmModel.ReleaseDateLayout, film.ReleaseDate)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.film.SaveFilm.ParseReleaseDate"}).Error(err)
+		return nil, err
+	}
 
+	res, err := a.db.ExecContext(ctx, `INSERT INTO film(name, episode_id, opening_crawl, director, producer, release_date, edited_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		film.Title, film.EpisodeID, film.OpeningCrawl, film.Director, film.Producer, releaseDate, film.Edited)
 	if err != nil {
 		logrus.WithFields(logrus.Fields{"trace": "store.film.SaveFilm.Exec"}).Error(err)
 		return nil, err
@@ -101,11 +110,29 @@ func (a *storeImpl) SaveFilm(ctx context.Context, film filmModel.ResultFilm) (*i
 	return &lastID, nil
 }
 
+func (a *storeImpl) UpdateFilm(ctx context.Context, id int64, film filmModel.ResultFilm) error {
+	releaseDate, err := time.Parse(filmModel.ReleaseDateLayout, film.ReleaseDate)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.film.UpdateFilm.ParseReleaseDate"}).Error(err)
+		return err
+	}
+
+	_, err = a.db.ExecContext(ctx, `UPDATE film SET episode_id = ?, opening_crawl = ?, director = ?, producer = ?, release_date = ?, edited_at = ?
+		WHERE id = ?`,
+		film.EpisodeID, film.OpeningCrawl, film.Director, film.Producer, releaseDate, film.Edited, id)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.film.UpdateFilm.Exec"}).Error(err)
+		return err
+	}
+
+	return nil
+}
+
 func (a *storeImpl) SaveFilmWithPlanet(ctx context.Context, planetID, filmID int64) (*int64, error) {
 	query := fmt.Sprintf("INSERT INTO film_planet(planet_id, film_id) VALUES ('%d','%d')",
 		planetID, filmID)
 
-	res, err := a.db.Exec(query)
+	res, err := a.db.ExecContext(ctx, query)
 	if err != nil {
 		logrus.WithFields(logrus.Fields{"trace": "store.film.SaveFilmWithPlanet.Exec"}).Error(err)
 		return nil, err
@@ -120,8 +147,40 @@ func (a *storeImpl) SaveFilmWithPlanet(ctx context.Context, planetID, filmID int
 	return &lastID, nil
 }
 
+// DeleteFilmsWithPlanet remove every link of the planet to its films, before linking it again..
+func (a *storeImpl) DeleteFilmsWithPlanet(ctx context.Context, planetID int64) error {
+	_, err := a.db.ExecContext(ctx, "DELETE FROM film_planet WHERE planet_id = ?", planetID)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.film.DeleteFilmsWithPlanet.Exec"}).Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// PurgeFilmsWithPlanet hard delete the film links soft deleted before deletedBefore
+// and every link of the planets that store.planet.Purge will delete..
+func (a *storeImpl) PurgeFilmsWithPlanet(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	before := deletedBefore.Format("2006-01-02 15:04:05")
+	res, err := a.db.ExecContext(ctx, `DELETE FROM film_planet
+		WHERE (deleted_at IS NOT NULL AND deleted_at < ?)
+			OR planet_id IN (SELECT id FROM planet WHERE deleted_at IS NOT NULL AND deleted_at < ?)`, before, before)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.film.PurgeFilmsWithPlanet.Exec"}).Error(err)
+		return 0, err
+	}
+
+	purged, err := res.RowsAffected()
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.film.PurgeFilmsWithPlanet.RowsAffected"}).Error(err)
+		return 0, err
+	}
+
+	return purged, nil
+}
+
 func (a *storeImpl) GetOne(ctx context.Context, name string) (*filmModel.Film, error) {
-	res, err := a.db.Query("SELECT * FROM film WHERE name = ?", name)
+	res, err := a.db.QueryContext(ctx, "SELECT "+filmColumns+" FROM film WHERE name = ?", name)
 	if err != nil {
 		logrus.WithFields(logrus.Fields{"trace": "store.film.GetOne.Query"}).Error(err)
 		return nil, err
@@ -129,27 +188,231 @@ func (a *storeImpl) GetOne(ctx context.Context, name string) (*filmModel.Film, e
 	defer res.Close()
 
 	if res.Next() {
-		var film filmModel.Film
-		err := res.Scan(
-			&film.ID,
-			&film.Name,
-			&film.Director,
-			&film.ReleaseDate,
-			&film.CreatedAt,
-		)
+		film, err := scanFilm(res)
 		if err != nil {
 			logrus.WithFields(logrus.Fields{"trace": "store.film.GetOne.Scan"}).Error(err)
 			return nil, err
 		}
 
-		return &film, nil
+		return film, nil
 	} else {
 		return nil, nil
 	}
 }
 
+func (a *storeImpl) GetOneByID(ctx context.Context, id int64) (*filmModel.Film, error) {
+	res, err := a.db.QueryContext(ctx, "SELECT "+filmColumns+" FROM film WHERE id = ?", id)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.film.GetOneByID.Query"}).Error(err)
+		return nil, err
+	}
+	defer res.Close()
+
+	if res.Next() {
+		film, err := scanFilm(res)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "store.film.GetOneByID.Scan"}).Error(err)
+			return nil, err
+		}
+
+		return film, nil
+	} else {
+		return nil, filmModel.ErrorFilmNotFound
+	}
+}
+
+// GetAll list limit films matching filter, skipping offset of them..
+func (a *storeImpl) GetAll(ctx context.Context, offset, limit int64, filter filmModel.Filter) ([]*filmModel.Film, error) {
+	where, params := filterConditions(filter)
+	query := `SELECT ` + filmColumns + ` FROM film WHERE ` + where + ` ORDER BY ` + keyset.OrderBy(filmKeyset, false) + ` LIMIT ? OFFSET ?`
+	params = append(params, limit, offset)
+
+	res, err := a.db.QueryContext(ctx, query, params...)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.film.GetAll.Query"}).Error(err)
+		return nil, err
+	}
+	defer res.Close()
+
+	var films []*filmModel.Film
+	for res.Next() {
+		film, err := scanFilm(res)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "store.film.GetAll.Scan"}).Error(err)
+			return nil, err
+		}
+		films = append(films, film)
+	}
+
+	return films, nil
+}
+
+// GetAllByCursor get up to limit films matching filter after the cursor, or before it when the cursor
+// is backward, in the order of the listing. A nil cursor starts from the first film..
+func (a *storeImpl) GetAllByCursor(ctx context.Context, cursor *genericModel.Cursor, limit int64, filter filmModel.Filter) ([]*filmModel.Film, error) {
+	where, params := filterConditions(filter)
+
+	backward := false
+	if cursor != nil {
+		condition, cursorParams, err := keyset.Condition(filmKeyset, *cursor)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "store.film.GetAllByCursor.Condition"}).Error(err)
+			return nil, err
+		}
+		where += ` AND ` + condition
+		params = append(params, cursorParams...)
+		backward = cursor.Backward
+	}
+
+	query := `SELECT ` + filmColumns + ` FROM film WHERE ` + where + ` ORDER BY ` + keyset.OrderBy(filmKeyset, backward) + ` LIMIT ?`
+	params = append(params, limit)
+
+	res, err := a.db.QueryContext(ctx, query, params...)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.film.GetAllByCursor.Query"}).Error(err)
+		return nil, err
+	}
+	defer res.Close()
+
+	var films []*filmModel.Film
+	for res.Next() {
+		film, err := scanFilm(res)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "store.film.GetAllByCursor.Scan"}).Error(err)
+			return nil, err
+		}
+		films = append(films, film)
+	}
+
+	// the rows before a backward cursor are read in the reverse order
+	if backward {
+		for i, j := 0, len(films)-1; i < j; i, j = i+1, j-1 {
+			films[i], films[j] = films[j], films[i]
+		}
+	}
+
+	return films, nil
+}
+
+// filterConditions build the WHERE of the film listing..
+func filterConditions(filter filmModel.Filter) (string, []interface{}) {
+	where := `1 = 1`
+	params := []interface{}{}
+	if len(filter.Title) > 0 {
+		params = append(params, "%"+filter.Title+"%")
+		where += ` AND name LIKE ?`
+	}
+
+	if len(filter.Director) > 0 {
+		params = append(params, "%"+filter.Director+"%")
+		where += ` AND director LIKE ?`
+	}
+
+	return where, params
+}
+
+// GetTotalFilms count the films matching filter..
+func (a *storeImpl) GetTotalFilms(ctx context.Context, filter filmModel.Filter) (*int64, error) {
+	where, params := filterConditions(filter)
+	res, err := a.db.QueryContext(ctx, "SELECT COUNT(*) FROM film WHERE "+where, params...)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.film.GetTotalFilms.Query"}).Error(err)
+		return nil, err
+	}
+	defer res.Close()
+
+	if res.Next() {
+		var film filmModel.FilmTotal
+		err := res.Scan(
+			&film.Total,
+		)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "store.film.GetTotalFilms.Scan"}).Error(err)
+			return nil, err
+		}
+
+		return &film.Total, nil
+	} else {
+		return nil, filmModel.ErrorFilmNotFound
+	}
+}
+
+// GetAllByPlanetID get the films linked to a planet, ignoring deleted links..
+func (a *storeImpl) GetAllByPlanetID(ctx context.Context, planetID, offset, limit int64) ([]*filmModel.Film, error) {
+	res, err := a.db.QueryContext(ctx, `SELECT `+filmColumns+` FROM film
+		WHERE id IN (SELECT film_id FROM film_planet WHERE planet_id = ? AND deleted_at IS NULL)
+		ORDER BY episode_id IS NULL, episode_id, id LIMIT ? OFFSET ?`, planetID, limit, offset)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.film.GetAllByPlanetID.Query"}).Error(err)
+		return nil, err
+	}
+	defer res.Close()
+
+	var films []*filmModel.Film
+	for res.Next() {
+		film, err := scanFilm(res)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "store.film.GetAllByPlanetID.Scan"}).Error(err)
+			return nil, err
+		}
+		films = append(films, film)
+	}
+
+	return films, nil
+}
+
+func (a *storeImpl) GetTotalFilmsByPlanetID(ctx context.Context, planetID int64) (*int64, error) {
+	res, err := a.db.QueryContext(ctx, `SELECT COUNT(*) FROM film
+		WHERE id IN (SELECT film_id FROM film_planet WHERE planet_id = ? AND deleted_at IS NULL)`, planetID)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.film.GetTotalFilmsByPlanetID.Query"}).Error(err)
+		return nil, err
+	}
+	defer res.Close()
+
+	if res.Next() {
+		var film filmModel.FilmTotal
+		err := res.Scan(
+			&film.Total,
+		)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "store.film.GetTotalFilmsByPlanetID.Scan"}).Error(err)
+			return nil, err
+		}
+
+		return &film.Total, nil
+	} else {
+		return nil, filmModel.ErrorFilmNotFound
+	}
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanFilm read a row selected with filmColumns..
+func scanFilm(res scanner) (*filmModel.Film, error) {
+	var film filmModel.Film
+	err := res.Scan(
+		&film.ID,
+		&film.Name,
+		&film.EpisodeID,
+		&film.OpeningCrawl,
+		&film.Director,
+		&film.Producer,
+		&film.ReleaseDate,
+		&film.CreatedAt,
+		&film.EditedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &film, nil
+}
+
 func (a *storeImpl) GetFilmWithPlanet(ctx context.Context, planetID, filmID int64) (*filmModel.FilmPlanet, error) {
-	res, err := a.db.Query("SELECT * FROM film_planet WHERE planet_id = ? and film_id = ?", planetID, filmID)
+	res, err := a.db.QueryContext(ctx, "SELECT planet_id, film_id, created_at, deleted_at FROM film_planet WHERE planet_id = ? and film_id = ?", planetID, filmID)
 	if err != nil {
 		logrus.WithFields(logrus.Fields{"trace": "store.film.GetFilmWithPlanet.Query"}).Error(err)
 		return nil, err
@@ -176,19 +439,33 @@ func (a *storeImpl) GetFilmWithPlanet(ctx context.Context, planetID, filmID int6
 }
 
 func (a *storeImpl) GetFilmsByPlanetIDs(ctx context.Context, planetIDs []int64) ([]filmModel.FilmPlanet, error) {
-	query, args, err := sqlx.In(`SELECT *
+	query, args, err := sqlx.In(`SELECT
+						star_wars.film_planet.planet_id,
+						star_wars.film_planet.film_id,
+						star_wars.film_planet.created_at,
+						star_wars.film_planet.deleted_at,
+						star_wars.film.id,
+						star_wars.film.name,
+						star_wars.film.episode_id,
+						star_wars.film.opening_crawl,
+						star_wars.film.director,
+						star_wars.film.producer,
+						star_wars.film.release_date,
+						star_wars.film.created_at,
+						star_wars.film.edited_at
 					FROM
 						star_wars.film_planet
 							LEFT JOIN
 						star_wars.film ON star_wars.film_planet.film_id = star_wars.film.id
-					WHERE star_wars.film_planet.planet_id IN (?);`, planetIDs)
+					WHERE star_wars.film_planet.planet_id IN (?)
+					ORDER BY star_wars.film.episode_id IS NULL, star_wars.film.episode_id, star_wars.film.id;`, planetIDs)
 	if err != nil {
 		logrus.WithFields(logrus.Fields{"trace": "store.film.GetFilmsByPlanetIDs.In"}).Error(err)
 		return nil, err
 	}
 
 	query = sqlx.Rebind(sqlx.QUESTION, query)
-	res, err := a.db.Query(query, args...)
+	res, err := a.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		logrus.WithFields(logrus.Fields{"trace": "store.film.GetFilmsByPlanetIDs.Query"}).Error(err)
 		return nil, err
@@ -205,9 +482,13 @@ func (a *storeImpl) GetFilmsByPlanetIDs(ctx context.Context, planetIDs []int64)
 			&film.DeletedAt,
 			&film.Film.ID,
 			&film.Film.Name,
+			&film.Film.EpisodeID,
+			&film.Film.OpeningCrawl,
 			&film.Film.Director,
+			&film.Film.Producer,
 			&film.Film.ReleaseDate,
 			&film.Film.CreatedAt,
+			&film.Film.EditedAt,
 		)
 		if err != nil {
 			logrus.WithFields(logrus.Fields{"trace": "store.film.GetFilmsByPlanetIDs.Scan"}).Error(err)
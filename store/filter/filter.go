@@ -0,0 +1,10 @@
+package filter
+
+// NameContains is the WHERE of the listings searching by name, empty when name is not sent..
+func NameContains(name string) (string, []interface{}) {
+	if len(name) == 0 {
+		return "", nil
+	}
+
+	return " WHERE name LIKE ?", []interface{}{"%" + name + "%"}
+}
@@ -0,0 +1,69 @@
+package keyset
+
+import (
+	"strings"
+
+	genericModel "github.com/danilotadeu/star_wars/model/generic"
+)
+
+// Column is a column, or expression, of a keyset ordering. The last column must be unique, usually the id.
+// A Nullable column is compared with the NULL-safe <=> and an empty key of the cursor is NULL, so it must
+// follow a "<column> IS NULL" column that keeps the NULLs together..
+type Column struct {
+	Name     string
+	Desc     bool
+	Nullable bool
+}
+
+// Condition build the WHERE of the rows after the cursor, or before it when the cursor is backward,
+// ErrorInvalidCursor when the cursor does not have a value for each column..
+func Condition(columns []Column, cursor genericModel.Cursor) (string, []interface{}, error) {
+	values := cursor.Values()
+	if len(values) != len(columns) {
+		return "", nil, genericModel.ErrorInvalidCursor
+	}
+
+	for idx, column := range columns {
+		if column.Nullable && values[idx] == "" {
+			values[idx] = nil
+		}
+	}
+
+	conditions := make([]string, len(columns))
+	var params []interface{}
+	for idx, column := range columns {
+		parts := make([]string, 0, idx+1)
+		for _, previous := range columns[:idx] {
+			equal := " = ?"
+			if previous.Nullable {
+				equal = " <=> ?"
+			}
+			parts = append(parts, previous.Name+equal)
+		}
+
+		operator := ">"
+		if column.Desc != cursor.Backward {
+			operator = "<"
+		}
+		parts = append(parts, column.Name+" "+operator+" ?")
+
+		conditions[idx] = "(" + strings.Join(parts, " AND ") + ")"
+		params = append(params, values[:idx+1]...)
+	}
+
+	return "(" + strings.Join(conditions, " OR ") + ")", params, nil
+}
+
+// OrderBy build the ORDER BY of the columns, reversed to read the rows before a backward cursor..
+func OrderBy(columns []Column, backward bool) string {
+	orders := make([]string, len(columns))
+	for idx, column := range columns {
+		direction := "ASC"
+		if column.Desc != backward {
+			direction = "DESC"
+		}
+		orders[idx] = column.Name + " " + direction
+	}
+
+	return strings.Join(orders, ", ")
+}
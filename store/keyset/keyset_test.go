@@ -0,0 +1,59 @@
+package keyset
+
+import (
+	"testing"
+
+	genericModel "github.com/danilotadeu/star_wars/model/generic"
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestCondition(t *testing.T) {
+	columns := []Column{{Name: "name"}, {Name: "created_at", Desc: true}, {Name: "id"}}
+	cases := map[string]struct {
+		columns           []Column
+		cursor            genericModel.Cursor
+		expectedCondition string
+		expectedParams    []interface{}
+		expectedErr       error
+	}{
+		"should get the rows after the cursor": {
+			columns:           columns,
+			cursor:            genericModel.Cursor{Keys: []string{"Tatooine", "2021-11-22 00:00:00"}, ID: 1},
+			expectedCondition: "((name > ?) OR (name = ? AND created_at < ?) OR (name = ? AND created_at = ? AND id > ?))",
+			expectedParams:    []interface{}{"Tatooine", "Tatooine", "2021-11-22 00:00:00", "Tatooine", "2021-11-22 00:00:00", int64(1)},
+		},
+		"should compare a nullable column with NULL when its key is empty": {
+			columns:           []Column{{Name: "episode_id IS NULL"}, {Name: "episode_id", Nullable: true}, {Name: "id"}},
+			cursor:            genericModel.Cursor{Keys: []string{"1", ""}, ID: 7},
+			expectedCondition: "((episode_id IS NULL > ?) OR (episode_id IS NULL = ? AND episode_id > ?) OR (episode_id IS NULL = ? AND episode_id <=> ? AND id > ?))",
+			expectedParams:    []interface{}{"1", "1", nil, "1", nil, int64(7)},
+		},
+		"should get the rows before a backward cursor": {
+			columns:           []Column{{Name: "id"}},
+			cursor:            genericModel.Cursor{ID: 1, Backward: true},
+			expectedCondition: "((id < ?))",
+			expectedParams:    []interface{}{int64(1)},
+		},
+		"should throw error when the cursor does not match the columns": {
+			columns:     columns,
+			cursor:      genericModel.Cursor{ID: 1},
+			expectedErr: genericModel.ErrorInvalidCursor,
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			condition, params, err := Condition(cs.columns, cs.cursor)
+			assert.Equal(t, cs.expectedErr, err)
+			assert.Equal(t, cs.expectedCondition, condition)
+			assert.Equal(t, cs.expectedParams, params)
+		})
+	}
+}
+
+func TestOrderBy(t *testing.T) {
+	columns := []Column{{Name: "name"}, {Name: "created_at", Desc: true}, {Name: "id"}}
+
+	assert.Equal(t, "name ASC, created_at DESC, id ASC", OrderBy(columns, false))
+	assert.Equal(t, "name DESC, created_at ASC, id DESC", OrderBy(columns, true))
+}
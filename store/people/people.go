@@ -0,0 +1,345 @@
+package people
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"time"
+
+	peopleModel "github.com/danilotadeu/star_wars/model/people"
+	"github.com/danilotadeu/star_wars/store/filter"
+	"github.com/danilotadeu/star_wars/store/swapi"
+	"github.com/danilotadeu/star_wars/store/transaction"
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+)
+
+// Store is a contract to People..
+//
+//go:generate mockgen -destination ../../mock/store/people/people_store_mock.go -package mockStorePeople . Store
+type Store interface {
+	WithTx(tx *sql.Tx) Store
+	GetPeople(ctx context.Context, people string) (*peopleModel.ResultPeople, error)
+	SavePeople(ctx context.Context, people peopleModel.ResultPeople, planetID *int64) (*int64, error)
+	UpdatePeople(ctx context.Context, id int64, people peopleModel.ResultPeople, planetID *int64) error
+	DetachDeletedHomeworld(ctx context.Context, deletedBefore time.Time) (int64, error)
+	GetOne(ctx context.Context, name string) (*peopleModel.PeopleDB, error)
+	GetOneByID(ctx context.Context, id int64) (*peopleModel.PeopleDB, error)
+	GetAll(ctx context.Context, offset, limit int64, name string) ([]*peopleModel.PeopleDB, error)
+	GetTotalPeople(ctx context.Context, name string) (*int64, error)
+	SaveFilmWithPeople(ctx context.Context, peopleID, filmID int64) error
+	GetFilmWithPeople(ctx context.Context, peopleID, filmID int64) (*peopleModel.FilmPeople, error)
+	GetFilmsByPeopleIDs(ctx context.Context, peopleIDs []int64) ([]peopleModel.FilmPeople, error)
+}
+
+const peopleColumns = "id, name, height, mass, hair_color, skin_color, eye_color, birth_year, gender, planet_id, created_at, edited_at"
+
+type storeImpl struct {
+	db          transaction.Executor
+	client      *http.Client
+	urlStarWars string
+}
+
+// NewStore init a people
+func NewStore(db *sql.DB, urlStarWars string, client *http.Client) Store {
+	return &storeImpl{
+		db:          db,
+		client:      client,
+		urlStarWars: urlStarWars,
+	}
+}
+
+// WithTx return a copy of the store running the queries inside tx..
+func (a *storeImpl) WithTx(tx *sql.Tx) Store {
+	return &storeImpl{
+		db:          tx,
+		client:      a.client,
+		urlStarWars: a.urlStarWars,
+	}
+}
+
+// GetPeople get a single people in api star wars..
+func (a *storeImpl) GetPeople(ctx context.Context, people string) (*peopleModel.ResultPeople, error) {
+	url, err := swapi.ResourceURL(a.urlStarWars, "people", people)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.people.GetPeople.ResourceURL"}).Error(err)
+		return nil, err
+	}
+
+	var responsePeople peopleModel.ResultPeople
+	err = swapi.Get(ctx, a.client, url, &responsePeople)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.people.GetPeople.Get"}).Error(err)
+		return nil, err
+	}
+
+	return &responsePeople, nil
+}
+
+func (a *storeImpl) SavePeople(ctx context.Context, people peopleModel.ResultPeople, planetID *int64) (*int64, error) {
+	res, err := a.db.ExecContext(ctx, `INSERT INTO people(name, height, mass, hair_color, skin_color, eye_color, birth_year, gender, planet_id, edited_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		people.Name, people.Height, people.Mass, people.HairColor, people.SkinColor, people.EyeColor, people.BirthYear, people.Gender, planetID, people.Edited)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.people.SavePeople.Exec"}).Error(err)
+		return nil, err
+	}
+
+	lastID, err := res.LastInsertId()
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.people.SavePeople.LastInsertId"}).Error(err)
+		return nil, err
+	}
+
+	return &lastID, nil
+}
+
+// DetachDeletedHomeworld clear the homeworld of the people born on planets soft deleted before deletedBefore,
+// so store.planet.Purge can delete them..
+func (a *storeImpl) DetachDeletedHomeworld(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	res, err := a.db.ExecContext(ctx, `UPDATE people SET planet_id = NULL
+		WHERE planet_id IN (SELECT id FROM planet WHERE deleted_at IS NOT NULL AND deleted_at < ?)`, deletedBefore.Format("2006-01-02 15:04:05"))
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.people.DetachDeletedHomeworld.Exec"}).Error(err)
+		return 0, err
+	}
+
+	detached, err := res.RowsAffected()
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.people.DetachDeletedHomeworld.RowsAffected"}).Error(err)
+		return 0, err
+	}
+
+	return detached, nil
+}
+
+func (a *storeImpl) UpdatePeople(ctx context.Context, id int64, people peopleModel.ResultPeople, planetID *int64) error {
+	_, err := a.db.ExecContext(ctx, `UPDATE people SET height = ?, mass = ?, hair_color = ?, skin_color = ?, eye_color = ?, birth_year = ?, gender = ?, planet_id = ?, edited_at = ?
+		WHERE id = ?`,
+		people.Height, people.Mass, people.HairColor, people.SkinColor, people.EyeColor, people.BirthYear, people.Gender, planetID, people.Edited, id)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.people.UpdatePeople.Exec"}).Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (a *storeImpl) GetOne(ctx context.Context, name string) (*peopleModel.PeopleDB, error) {
+	res, err := a.db.QueryContext(ctx, "SELECT "+peopleColumns+" FROM people WHERE name = ?", name)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.people.GetOne.Query"}).Error(err)
+		return nil, err
+	}
+	defer res.Close()
+
+	if res.Next() {
+		people, err := scanPeople(res)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "store.people.GetOne.Scan"}).Error(err)
+			return nil, err
+		}
+
+		return people, nil
+	} else {
+		return nil, nil
+	}
+}
+
+func (a *storeImpl) GetOneByID(ctx context.Context, id int64) (*peopleModel.PeopleDB, error) {
+	res, err := a.db.QueryContext(ctx, "SELECT "+peopleColumns+" FROM people WHERE id = ?", id)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.people.GetOneByID.Query"}).Error(err)
+		return nil, err
+	}
+	defer res.Close()
+
+	if res.Next() {
+		people, err := scanPeople(res)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "store.people.GetOneByID.Scan"}).Error(err)
+			return nil, err
+		}
+
+		return people, nil
+	} else {
+		return nil, peopleModel.ErrorPeopleNotFound
+	}
+}
+
+// GetAll list limit people whose name contains name, skipping offset of them..
+func (a *storeImpl) GetAll(ctx context.Context, offset, limit int64, name string) ([]*peopleModel.PeopleDB, error) {
+	where, params := filter.NameContains(name)
+	query := `SELECT ` + peopleColumns + ` FROM people` + where + ` ORDER BY id LIMIT ? OFFSET ?`
+	params = append(params, limit, offset)
+
+	res, err := a.db.QueryContext(ctx, query, params...)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.people.GetAll.Query"}).Error(err)
+		return nil, err
+	}
+	defer res.Close()
+
+	var results []*peopleModel.PeopleDB
+	for res.Next() {
+		people, err := scanPeople(res)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "store.people.GetAll.Scan"}).Error(err)
+			return nil, err
+		}
+		results = append(results, people)
+	}
+
+	return results, nil
+}
+
+// GetTotalPeople count the people listed by GetAll with the same name..
+func (a *storeImpl) GetTotalPeople(ctx context.Context, name string) (*int64, error) {
+	where, params := filter.NameContains(name)
+	res, err := a.db.QueryContext(ctx, "SELECT COUNT(*) FROM people"+where, params...)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.people.GetTotalPeople.Query"}).Error(err)
+		return nil, err
+	}
+	defer res.Close()
+
+	if res.Next() {
+		var people peopleModel.PeopleTotal
+		err := res.Scan(
+			&people.Total,
+		)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "store.people.GetTotalPeople.Scan"}).Error(err)
+			return nil, err
+		}
+
+		return &people.Total, nil
+	} else {
+		return nil, peopleModel.ErrorPeopleNotFound
+	}
+}
+
+func (a *storeImpl) SaveFilmWithPeople(ctx context.Context, peopleID, filmID int64) error {
+	_, err := a.db.ExecContext(ctx, "INSERT INTO film_people(people_id, film_id) VALUES (?, ?)", peopleID, filmID)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.people.SaveFilmWithPeople.Exec"}).Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (a *storeImpl) GetFilmWithPeople(ctx context.Context, peopleID, filmID int64) (*peopleModel.FilmPeople, error) {
+	res, err := a.db.QueryContext(ctx, "SELECT people_id, film_id, created_at FROM film_people WHERE people_id = ? and film_id = ?", peopleID, filmID)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.people.GetFilmWithPeople.Query"}).Error(err)
+		return nil, err
+	}
+	defer res.Close()
+
+	if res.Next() {
+		var film peopleModel.FilmPeople
+		err := res.Scan(
+			&film.PeopleID,
+			&film.FilmID,
+			&film.CreatedAt,
+		)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "store.people.GetFilmWithPeople.Scan"}).Error(err)
+			return nil, err
+		}
+
+		return &film, nil
+	} else {
+		return nil, nil
+	}
+}
+
+func (a *storeImpl) GetFilmsByPeopleIDs(ctx context.Context, peopleIDs []int64) ([]peopleModel.FilmPeople, error) {
+	query, args, err := sqlx.In(`SELECT
+						star_wars.film_people.people_id,
+						star_wars.film_people.film_id,
+						star_wars.film_people.created_at,
+						star_wars.film.id,
+						star_wars.film.name,
+						star_wars.film.episode_id,
+						star_wars.film.opening_crawl,
+						star_wars.film.director,
+						star_wars.film.producer,
+						star_wars.film.release_date,
+						star_wars.film.created_at,
+						star_wars.film.edited_at
+					FROM
+						star_wars.film_people
+							INNER JOIN
+						star_wars.film ON star_wars.film_people.film_id = star_wars.film.id
+					WHERE star_wars.film_people.people_id IN (?)
+					ORDER BY star_wars.film.episode_id IS NULL, star_wars.film.episode_id, star_wars.film.id;`, peopleIDs)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.people.GetFilmsByPeopleIDs.In"}).Error(err)
+		return nil, err
+	}
+
+	query = sqlx.Rebind(sqlx.QUESTION, query)
+	res, err := a.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.people.GetFilmsByPeopleIDs.Query"}).Error(err)
+		return nil, err
+	}
+	defer res.Close()
+
+	var films []peopleModel.FilmPeople
+	for res.Next() {
+		var film peopleModel.FilmPeople
+		err := res.Scan(
+			&film.PeopleID,
+			&film.FilmID,
+			&film.CreatedAt,
+			&film.Film.ID,
+			&film.Film.Name,
+			&film.Film.EpisodeID,
+			&film.Film.OpeningCrawl,
+			&film.Film.Director,
+			&film.Film.Producer,
+			&film.Film.ReleaseDate,
+			&film.Film.CreatedAt,
+			&film.Film.EditedAt,
+		)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "store.people.GetFilmsByPeopleIDs.Scan"}).Error(err)
+			return nil, err
+		}
+
+		films = append(films, film)
+	}
+
+	return films, nil
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanPeople read a row selected with peopleColumns..
+func scanPeople(res scanner) (*peopleModel.PeopleDB, error) {
+	var people peopleModel.PeopleDB
+	err := res.Scan(
+		&people.ID,
+		&people.Name,
+		&people.Height,
+		&people.Mass,
+		&people.HairColor,
+		&people.SkinColor,
+		&people.EyeColor,
+		&people.BirthYear,
+		&people.Gender,
+		&people.PlanetID,
+		&people.CreatedAt,
+		&people.EditedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &people, nil
+}
@@ -3,100 +3,126 @@ package planet
 import (
 	"context"
 	"database/sql"
-	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
 	"net/http"
 	"strconv"
 	"time"
 
+	genericModel "github.com/danilotadeu/star_wars/model/generic"
 	planetModel "github.com/danilotadeu/star_wars/model/planet"
+	"github.com/danilotadeu/star_wars/store/keyset"
+	"github.com/danilotadeu/star_wars/store/swapi"
+	"github.com/danilotadeu/star_wars/store/transaction"
+	"github.com/go-sql-driver/mysql"
 	"github.com/sirupsen/logrus"
 )
 
+// errorDuplicateEntry is the MySQL error number of a violated unique constraint, like UC_PLANET_NAME..
+const errorDuplicateEntry = 1062
+
 // Store is a contract to Planet..
 //
 //go:generate mockgen -destination ../../mock/store/planet/planet_store_mock.go -package mockStorePlanet . Store
 type Store interface {
-	GetPlanets(ctx context.Context) ([]planetModel.ResultPlanet, error)
+	WithTx(tx *sql.Tx) Store
+	GetPlanetsPage(ctx context.Context, page int) (*planetModel.ResultPlanet, error)
 	SavePlanet(ctx context.Context, planet planetModel.Planet) (*int64, error)
+	UpdatePlanet(ctx context.Context, id int64, planet planetModel.Planet) error
+	CreatePlanet(ctx context.Context, planet planetModel.PlanetRequest) (*int64, error)
+	ReplacePlanet(ctx context.Context, id int64, planet planetModel.PlanetRequest) error
 	GetOne(ctx context.Context, name string) (*planetModel.PlanetDB, error)
 	GetOneByID(ctx context.Context, id int64) (*planetModel.PlanetDB, error)
-	GetAll(ctx context.Context, page, limit int64, name string) ([]*planetModel.PlanetDB, error)
+	GetAll(ctx context.Context, offset, limit int64, filter planetModel.Filter) ([]*planetModel.PlanetDB, error)
+	GetAllByCursor(ctx context.Context, cursor *genericModel.Cursor, limit int64, filter planetModel.Filter) ([]*planetModel.PlanetDB, error)
 	Delete(ctx context.Context, id int64) error
-	GetTotalPlanets(ctx context.Context) (*int64, error)
+	GetTotalPlanets(ctx context.Context, filter planetModel.Filter) (*int64, error)
+	GetAllDeleted(ctx context.Context, offset, limit int64) ([]*planetModel.PlanetDB, error)
+	GetTotalDeletedPlanets(ctx context.Context) (*int64, error)
+	GetDeletedByID(ctx context.Context, id int64) (*planetModel.PlanetDB, error)
+	GetDeletedByName(ctx context.Context, name string) (*planetModel.PlanetDB, error)
+	Restore(ctx context.Context, id int64) error
+	Purge(ctx context.Context, deletedBefore time.Time) (int64, error)
+	GetAllByFilmID(ctx context.Context, filmID, offset, limit int64) ([]*planetModel.PlanetDB, error)
+	GetTotalPlanetsByFilmID(ctx context.Context, filmID int64) (*int64, error)
+}
+
+const planetColumns = "id, name, climate, terrain, rotation_period, orbital_period, diameter, gravity, surface_water, population, created_at, deleted_at, edited_at"
+
+// sortColumns is the SQL of each planetModel.SortFields, matching planetModel.PlanetDB.Cursor: the unknown
+// numbers are -1 so the keyset comparisons do not skip them, and film_count counts the same links
+// the listing attaches..
+var sortColumns = map[string]string{
+	"name":            "name",
+	"climate":         "climate",
+	"terrain":         "terrain",
+	"rotation_period": "COALESCE(rotation_period, -1)",
+	"orbital_period":  "COALESCE(orbital_period, -1)",
+	"diameter":        "COALESCE(diameter, -1)",
+	"surface_water":   "COALESCE(surface_water, -1)",
+	"population":      "COALESCE(population, -1)",
+	"created_at":      "created_at",
+	"film_count":      "(SELECT COUNT(*) FROM film_planet WHERE film_planet.planet_id = planet.id)",
+}
+
+// planetKeyset is the ordering of the planet listings by sorts, with the id breaking the ties..
+func planetKeyset(sorts []planetModel.Sort) []keyset.Column {
+	columns := make([]keyset.Column, 0, len(sorts)+1)
+	for _, order := range sorts {
+		columns = append(columns, keyset.Column{Name: sortColumns[order.Field], Desc: order.Desc})
+	}
+
+	return append(columns, keyset.Column{Name: "id"})
 }
 
 type storeImpl struct {
-	db          *sql.DB
+	db          transaction.Executor
+	client      *http.Client
 	urlStarWars string
 }
 
 // NewApp init a planet
-func NewStore(db *sql.DB, urlStarWars string) Store {
+func NewStore(db *sql.DB, urlStarWars string, client *http.Client) Store {
 	return &storeImpl{
 		db:          db,
+		client:      client,
 		urlStarWars: urlStarWars,
 	}
 }
 
-// GetPlanets get all planets..
-func (a *storeImpl) GetPlanets(ctx context.Context) ([]planetModel.ResultPlanet, error) {
-	page := 1
-	var resultsPlanet []planetModel.ResultPlanet
-
-	for {
-		client := &http.Client{}
-		url := a.urlStarWars + "/planets"
-
-		if page > 1 {
-			url += "/?page=" + strconv.Itoa(page)
-		}
-
-		req, err := http.NewRequest("GET", url, nil)
-		if err != nil {
-			logrus.WithFields(logrus.Fields{"trace": "store.planet.GetPlanets.newRequest"}).Error(err)
-			return nil, err
-		}
-		req.Header.Add("Accept", "application/json")
-		resp, err := client.Do(req)
-
-		if err != nil {
-			logrus.WithFields(logrus.Fields{"trace": "store.planet.GetPlanets.Do"}).Error(err)
-			return nil, err
-		}
-
-		defer resp.Body.Close()
-		respBody, err := io.ReadAll(resp.Body)
-		if err != nil {
-			logrus.WithFields(logrus.Fields{"trace": "store.planet.GetPlanets.readAll"}).Error(err)
-			return nil, err
-		}
+// WithTx return a copy of the store running the queries inside tx..
+func (a *storeImpl) WithTx(tx *sql.Tx) Store {
+	return &storeImpl{
+		db:          tx,
+		client:      a.client,
+		urlStarWars: a.urlStarWars,
+	}
+}
 
-		if resp.StatusCode == http.StatusOK {
-			var responsePlanet planetModel.ResultPlanet
-			err := json.Unmarshal(respBody, &responsePlanet)
-			if err != nil {
-				logrus.WithFields(logrus.Fields{"trace": "store.planet.GetPlanets.jsonUnmarshal"}).Error(err)
-				return nil, err
-			}
+// GetPlanetsPage get a single page of planets..
+func (a *storeImpl) GetPlanetsPage(ctx context.Context, page int) (*planetModel.ResultPlanet, error) {
+	url := a.urlStarWars + "/planets"
 
-			resultsPlanet = append(resultsPlanet, responsePlanet)
-			if responsePlanet.Next == nil {
-				break
-			}
+	if page > 1 {
+		url += "/?page=" + strconv.Itoa(page)
+	}
 
-			page++
-		}
+	var responsePlanet planetModel.ResultPlanet
+	err := swapi.Get(ctx, a.client, url, &responsePlanet)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.planet.GetPlanetsPage.Get"}).Error(err)
+		return nil, err
 	}
 
-	return resultsPlanet, nil
+	return &responsePlanet, nil
 }
 
 func (a *storeImpl) SavePlanet(ctx context.Context, planet planetModel.Planet) (*int64, error) {
-	query := fmt.Sprintf("INSERT INTO planet(name, climate, terrain) VALUES ('%s','%s','%s')",
-		planet.Name, planet.Climate, planet.Terrain)
-	res, err := a.db.Exec(query)
+	res, err := a.db.ExecContext(ctx, `INSERT INTO planet(name, climate, terrain, rotation_period, orbital_period, diameter, gravity, surface_water, population, edited_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		planet.Name, planet.Climate, planet.Terrain,
+		genericModel.ParseInt(planet.RotationPeriod), genericModel.ParseInt(planet.OrbitalPeriod), genericModel.ParseInt(planet.Diameter),
+		planet.Gravity, genericModel.ParseFloat(planet.SurfaceWater), genericModel.ParseInt(planet.Population), planet.Edited)
 
 	if err != nil {
 		logrus.WithFields(logrus.Fields{"trace": "store.planet.SavePlanet.Exec"}).Error(err)
@@ -112,8 +138,81 @@ func (a *storeImpl) SavePlanet(ctx context.Context, planet planetModel.Planet) (
 	return &lastId, nil
 }
 
+func (a *storeImpl) UpdatePlanet(ctx context.Context, id int64, planet planetModel.Planet) error {
+	_, err := a.db.ExecContext(ctx, `UPDATE planet SET climate = ?, terrain = ?, rotation_period = ?, orbital_period = ?, diameter = ?, gravity = ?, surface_water = ?, population = ?, edited_at = ?
+		WHERE id = ?`,
+		planet.Climate, planet.Terrain,
+		genericModel.ParseInt(planet.RotationPeriod), genericModel.ParseInt(planet.OrbitalPeriod), genericModel.ParseInt(planet.Diameter),
+		planet.Gravity, genericModel.ParseFloat(planet.SurfaceWater), genericModel.ParseInt(planet.Population), planet.Edited, id)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.planet.UpdatePlanet.Exec"}).Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// CreatePlanet insert a planet sent to the api, ErrorPlanetAlreadyExists when the name is taken..
+func (a *storeImpl) CreatePlanet(ctx context.Context, planet planetModel.PlanetRequest) (*int64, error) {
+	res, err := a.db.ExecContext(ctx, `INSERT INTO planet(name, climate, terrain, rotation_period, orbital_period, diameter, gravity, surface_water, population)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		planet.Name, planet.Climate, planet.Terrain, planet.RotationPeriod, planet.OrbitalPeriod, planet.Diameter,
+		planet.Gravity, planet.SurfaceWater, planet.Population)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.planet.CreatePlanet.Exec"}).Error(err)
+		if isDuplicateEntry(err) {
+			return nil, planetModel.ErrorPlanetAlreadyExists
+		}
+		return nil, err
+	}
+
+	lastID, err := res.LastInsertId()
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.planet.CreatePlanet.LastInsertId"}).Error(err)
+		return nil, err
+	}
+
+	return &lastID, nil
+}
+
+// ReplacePlanet update every attribute of an active planet, ErrorPlanetNotFound when it does not exist or was deleted
+// and ErrorPlanetAlreadyExists when the name is taken. The row is locked first, so on a transaction it can not be
+// deleted before the commit..
+func (a *storeImpl) ReplacePlanet(ctx context.Context, id int64, planet planetModel.PlanetRequest) error {
+	res, err := a.db.QueryContext(ctx, "SELECT id FROM planet WHERE id = ? AND deleted_at IS NULL FOR UPDATE", id)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.planet.ReplacePlanet.Query"}).Error(err)
+		return err
+	}
+	found := res.Next()
+	err = res.Err()
+	res.Close()
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.planet.ReplacePlanet.Next"}).Error(err)
+		return err
+	}
+
+	if !found {
+		return planetModel.ErrorPlanetNotFound
+	}
+
+	_, err = a.db.ExecContext(ctx, `UPDATE planet SET name = ?, climate = ?, terrain = ?, rotation_period = ?, orbital_period = ?, diameter = ?, gravity = ?, surface_water = ?, population = ?
+		WHERE id = ? AND deleted_at IS NULL`,
+		planet.Name, planet.Climate, planet.Terrain, planet.RotationPeriod, planet.OrbitalPeriod, planet.Diameter,
+		planet.Gravity, planet.SurfaceWater, planet.Population, id)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.planet.ReplacePlanet.Exec"}).Error(err)
+		if isDuplicateEntry(err) {
+			return planetModel.ErrorPlanetAlreadyExists
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (a *storeImpl) GetOne(ctx context.Context, name string) (*planetModel.PlanetDB, error) {
-	res, err := a.db.Query("SELECT * FROM planet WHERE deleted_at IS NULL and name = ?", name)
+	res, err := a.db.QueryContext(ctx, "SELECT "+planetColumns+" FROM planet WHERE deleted_at IS NULL and name = ?", name)
 	if err != nil {
 		logrus.WithFields(logrus.Fields{"trace": "store.planet.GetOne.Query"}).Error(err)
 		return nil, err
@@ -121,28 +220,20 @@ func (a *storeImpl) GetOne(ctx context.Context, name string) (*planetModel.Plane
 	defer res.Close()
 
 	if res.Next() {
-		var planet planetModel.PlanetDB
-		err := res.Scan(
-			&planet.ID,
-			&planet.Name,
-			&planet.Climate,
-			&planet.Terrain,
-			&planet.CreatedAt,
-			&planet.DeletedAt,
-		)
+		planet, err := scanPlanet(res)
 		if err != nil {
 			logrus.WithFields(logrus.Fields{"trace": "store.planet.GetOne.Scan"}).Error(err)
 			return nil, err
 		}
 
-		return &planet, nil
+		return planet, nil
 	} else {
 		return nil, nil
 	}
 }
 
 func (a *storeImpl) GetOneByID(ctx context.Context, id int64) (*planetModel.PlanetDB, error) {
-	res, err := a.db.Query("SELECT * FROM planet WHERE deleted_at IS NULL and id = ?", id)
+	res, err := a.db.QueryContext(ctx, "SELECT "+planetColumns+" FROM planet WHERE deleted_at IS NULL and id = ?", id)
 	if err != nil {
 		logrus.WithFields(logrus.Fields{"trace": "store.planet.GetOneByID.Query"}).Error(err)
 		return nil, err
@@ -150,38 +241,25 @@ func (a *storeImpl) GetOneByID(ctx context.Context, id int64) (*planetModel.Plan
 	defer res.Close()
 
 	if res.Next() {
-		var planet planetModel.PlanetDB
-		err := res.Scan(
-			&planet.ID,
-			&planet.Name,
-			&planet.Climate,
-			&planet.Terrain,
-			&planet.CreatedAt,
-			&planet.DeletedAt,
-		)
+		planet, err := scanPlanet(res)
 		if err != nil {
 			logrus.WithFields(logrus.Fields{"trace": "store.planet.GetOneByID.Scan"}).Error(err)
 			return nil, err
 		}
 
-		return &planet, nil
+		return planet, nil
 	} else {
 		return nil, planetModel.ErrorPlanetNotFound
 	}
 }
 
-func (a *storeImpl) GetAll(ctx context.Context, page, limit int64, name string) ([]*planetModel.PlanetDB, error) {
-	query := `SELECT * FROM planet WHERE deleted_at IS NULL`
-	params := []interface{}{}
-	if len(name) > 0 {
-		params = append(params, "%"+name+"%")
-		query += ` AND name LIKE ? `
-	}
-
-	query += ` LIMIT ? OFFSET ?`
-	params = append(params, limit, page)
+// GetAll get a page of the planets matching filter, in the order of filter.Sort..
+func (a *storeImpl) GetAll(ctx context.Context, offset, limit int64, filter planetModel.Filter) ([]*planetModel.PlanetDB, error) {
+	where, params := filterConditions(filter)
+	query := `SELECT ` + planetColumns + ` FROM planet WHERE ` + where + ` ORDER BY ` + keyset.OrderBy(planetKeyset(filter.Sort), false) + ` LIMIT ? OFFSET ?`
+	params = append(params, limit, offset)
 
-	res, err := a.db.Query(query, params...)
+	res, err := a.db.QueryContext(ctx, query, params...)
 	if err != nil {
 		logrus.WithFields(logrus.Fields{"trace": "store.planet.GetAll.Query"}).Error(err)
 		return nil, err
@@ -190,28 +268,110 @@ func (a *storeImpl) GetAll(ctx context.Context, page, limit int64, name string)
 
 	var results []*planetModel.PlanetDB
 	for res.Next() {
-		var planet planetModel.PlanetDB
-		err := res.Scan(
-			&planet.ID,
-			&planet.Name,
-			&planet.Climate,
-			&planet.Terrain,
-			&planet.CreatedAt,
-			&planet.DeletedAt,
-		)
+		planet, err := scanPlanet(res)
 		if err != nil {
 			logrus.WithFields(logrus.Fields{"trace": "store.planet.GetAll.Scan"}).Error(err)
 			return nil, err
 		}
-		results = append(results, &planet)
+		results = append(results, planet)
 	}
 
 	return results, nil
 }
 
+// GetAllByCursor get up to limit planets matching filter after the cursor, or before it when the cursor
+// is backward, in the order of the listing. A nil cursor starts from the first planet..
+func (a *storeImpl) GetAllByCursor(ctx context.Context, cursor *genericModel.Cursor, limit int64, filter planetModel.Filter) ([]*planetModel.PlanetDB, error) {
+	where, params := filterConditions(filter)
+	columns := planetKeyset(filter.Sort)
+
+	backward := false
+	if cursor != nil {
+		condition, cursorParams, err := keyset.Condition(columns, *cursor)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "store.planet.GetAllByCursor.Condition"}).Error(err)
+			return nil, err
+		}
+		where += ` AND ` + condition
+		params = append(params, cursorParams...)
+		backward = cursor.Backward
+	}
+
+	query := `SELECT ` + planetColumns + ` FROM planet WHERE ` + where + ` ORDER BY ` + keyset.OrderBy(columns, backward) + ` LIMIT ?`
+	params = append(params, limit)
+
+	res, err := a.db.QueryContext(ctx, query, params...)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.planet.GetAllByCursor.Query"}).Error(err)
+		return nil, err
+	}
+	defer res.Close()
+
+	var results []*planetModel.PlanetDB
+	for res.Next() {
+		planet, err := scanPlanet(res)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "store.planet.GetAllByCursor.Scan"}).Error(err)
+			return nil, err
+		}
+		results = append(results, planet)
+	}
+
+	// the rows before a backward cursor are read in the reverse order
+	if backward {
+		for i, j := 0, len(results)-1; i < j; i, j = i+1, j-1 {
+			results[i], results[j] = results[j], results[i]
+		}
+	}
+
+	return results, nil
+}
+
+// filterConditions build the WHERE of the planet listings, shared by GetAll and GetTotalPlanets
+// so the total always counts the same rows that are paginated..
+func filterConditions(filter planetModel.Filter) (string, []interface{}) {
+	where := `deleted_at IS NULL`
+	params := []interface{}{}
+	if len(filter.Name) > 0 {
+		params = append(params, "%"+filter.Name+"%")
+		where += ` AND name LIKE ?`
+	}
+
+	// climate and terrain are lists like "temperate, tropical", so each token is matched as a whole item
+	for _, climate := range filter.Climates {
+		params = append(params, climate)
+		where += ` AND FIND_IN_SET(?, REPLACE(climate, ', ', ','))`
+	}
+
+	for _, terrain := range filter.Terrains {
+		params = append(params, terrain)
+		where += ` AND FIND_IN_SET(?, REPLACE(terrain, ', ', ','))`
+	}
+
+	if filter.FilmID > 0 {
+		params = append(params, filter.FilmID)
+		where += ` AND id IN (SELECT planet_id FROM film_planet WHERE film_id = ? AND deleted_at IS NULL)`
+	}
+
+	// the fields and operators come from planetModel.ParseRange allowlists, only the values are parameters
+	for _, rangeFilter := range filter.Ranges {
+		if rangeFilter.Operator == planetModel.OperatorBetween {
+			where += ` AND ` + rangeFilter.Field + ` BETWEEN ? AND ?`
+		} else {
+			where += ` AND ` + rangeFilter.Field + ` ` + planetModel.RangeOperators[rangeFilter.Operator] + ` ?`
+		}
+
+		for _, value := range rangeFilter.Values {
+			params = append(params, value)
+		}
+	}
+
+	return where, params
+}
+
 func (a *storeImpl) Delete(ctx context.Context, id int64) error {
 	query := fmt.Sprintf("UPDATE planet SET deleted_at = '%s' WHERE id = '%d'", time.Now().Format("2006-01-02 15:04:05"), id)
-	res, err := a.db.Exec(query)
+	res, err := a.db.ExecContext(ctx, query)
 	if err != nil {
 		logrus.WithFields(logrus.Fields{"trace": "store.planet.Delete.Exec_1"}).Error(err)
 		return err
@@ -223,7 +383,7 @@ func (a *storeImpl) Delete(ctx context.Context, id int64) error {
 		return err
 	}
 
-	resFilmPlanet, err := a.db.Exec("UPDATE film_planet SET deleted_at = ? WHERE planet_id = ?", time.Now().Format("2006-01-02 15:04:05"), id)
+	resFilmPlanet, err := a.db.ExecContext(ctx, "UPDATE film_planet SET deleted_at = ? WHERE planet_id = ?", time.Now().Format("2006-01-02 15:04:05"), id)
 	if err != nil {
 		logrus.WithFields(logrus.Fields{"trace": "store.planet.Delete.Exec_2"}).Error(err)
 		return err
@@ -238,8 +398,10 @@ func (a *storeImpl) Delete(ctx context.Context, id int64) error {
 	return nil
 }
 
-func (a *storeImpl) GetTotalPlanets(ctx context.Context) (*int64, error) {
-	res, err := a.db.Query("SELECT COUNT(*) FROM planet WHERE deleted_at IS NULL")
+// GetTotalPlanets count the planets matching filter..
+func (a *storeImpl) GetTotalPlanets(ctx context.Context, filter planetModel.Filter) (*int64, error) {
+	where, params := filterConditions(filter)
+	res, err := a.db.QueryContext(ctx, `SELECT COUNT(*) FROM planet WHERE `+where, params...)
 	if err != nil {
 		logrus.WithFields(logrus.Fields{"trace": "store.planet.getTotalPlanets.Query"}).Error(err)
 		return nil, err
@@ -261,3 +423,217 @@ func (a *storeImpl) GetTotalPlanets(ctx context.Context) (*int64, error) {
 		return nil, planetModel.ErrorPlanetNotFound
 	}
 }
+
+// GetAllDeleted get the soft deleted planets, the last deleted first..
+func (a *storeImpl) GetAllDeleted(ctx context.Context, offset, limit int64) ([]*planetModel.PlanetDB, error) {
+	res, err := a.db.QueryContext(ctx, `SELECT `+planetColumns+` FROM planet WHERE deleted_at IS NOT NULL
+		ORDER BY deleted_at DESC, id LIMIT ? OFFSET ?`, limit, offset)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.planet.GetAllDeleted.Query"}).Error(err)
+		return nil, err
+	}
+	defer res.Close()
+
+	var results []*planetModel.PlanetDB
+	for res.Next() {
+		planet, err := scanPlanet(res)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "store.planet.GetAllDeleted.Scan"}).Error(err)
+			return nil, err
+		}
+		results = append(results, planet)
+	}
+
+	return results, nil
+}
+
+func (a *storeImpl) GetTotalDeletedPlanets(ctx context.Context) (*int64, error) {
+	res, err := a.db.QueryContext(ctx, "SELECT COUNT(*) FROM planet WHERE deleted_at IS NOT NULL")
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.planet.GetTotalDeletedPlanets.Query"}).Error(err)
+		return nil, err
+	}
+	defer res.Close()
+
+	if res.Next() {
+		var planet planetModel.PlanetsTotal
+		err := res.Scan(
+			&planet.Total,
+		)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "store.planet.GetTotalDeletedPlanets.Scan"}).Error(err)
+			return nil, err
+		}
+
+		return &planet.Total, nil
+	} else {
+		return nil, planetModel.ErrorPlanetNotFound
+	}
+}
+
+// GetDeletedByID get a soft deleted planet, ErrorPlanetNotFound when it does not exist or is active..
+func (a *storeImpl) GetDeletedByID(ctx context.Context, id int64) (*planetModel.PlanetDB, error) {
+	res, err := a.db.QueryContext(ctx, "SELECT "+planetColumns+" FROM planet WHERE deleted_at IS NOT NULL and id = ?", id)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.planet.GetDeletedByID.Query"}).Error(err)
+		return nil, err
+	}
+	defer res.Close()
+
+	if res.Next() {
+		planet, err := scanPlanet(res)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "store.planet.GetDeletedByID.Scan"}).Error(err)
+			return nil, err
+		}
+
+		return planet, nil
+	} else {
+		return nil, planetModel.ErrorPlanetNotFound
+	}
+}
+
+// GetDeletedByName get a soft deleted planet named name, nil when none is in the trash..
+func (a *storeImpl) GetDeletedByName(ctx context.Context, name string) (*planetModel.PlanetDB, error) {
+	res, err := a.db.QueryContext(ctx, "SELECT "+planetColumns+" FROM planet WHERE deleted_at IS NOT NULL and name = ? LIMIT 1", name)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.planet.GetDeletedByName.Query"}).Error(err)
+		return nil, err
+	}
+	defer res.Close()
+
+	if res.Next() {
+		planet, err := scanPlanet(res)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "store.planet.GetDeletedByName.Scan"}).Error(err)
+			return nil, err
+		}
+
+		return planet, nil
+	} else {
+		return nil, nil
+	}
+}
+
+// Restore clear deleted_at of the planet and its film links, ErrorPlanetAlreadyExists when an active planet took the name..
+func (a *storeImpl) Restore(ctx context.Context, id int64) error {
+	_, err := a.db.ExecContext(ctx, "UPDATE planet SET deleted_at = NULL WHERE id = ?", id)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.planet.Restore.Exec_1"}).Error(err)
+		if isDuplicateEntry(err) {
+			return planetModel.ErrorPlanetAlreadyExists
+		}
+		return err
+	}
+
+	_, err = a.db.ExecContext(ctx, "UPDATE film_planet SET deleted_at = NULL WHERE planet_id = ?", id)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.planet.Restore.Exec_2"}).Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// Purge hard delete the planets soft deleted before deletedBefore.
+// Their film links and homeworld references must be removed first..
+func (a *storeImpl) Purge(ctx context.Context, deletedBefore time.Time) (int64, error) {
+	res, err := a.db.ExecContext(ctx, "DELETE FROM planet WHERE deleted_at IS NOT NULL AND deleted_at < ?", deletedBefore.Format("2006-01-02 15:04:05"))
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.planet.Purge.Exec"}).Error(err)
+		return 0, err
+	}
+
+	purged, err := res.RowsAffected()
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.planet.Purge.RowsAffected"}).Error(err)
+		return 0, err
+	}
+
+	return purged, nil
+}
+
+// GetAllByFilmID get the planets linked to a film, ignoring deleted planets and links..
+func (a *storeImpl) GetAllByFilmID(ctx context.Context, filmID, offset, limit int64) ([]*planetModel.PlanetDB, error) {
+	res, err := a.db.QueryContext(ctx, `SELECT `+planetColumns+` FROM planet
+		WHERE deleted_at IS NULL
+			AND id IN (SELECT planet_id FROM film_planet WHERE film_id = ? AND deleted_at IS NULL)
+		ORDER BY id LIMIT ? OFFSET ?`, filmID, limit, offset)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.planet.GetAllByFilmID.Query"}).Error(err)
+		return nil, err
+	}
+	defer res.Close()
+
+	var results []*planetModel.PlanetDB
+	for res.Next() {
+		planet, err := scanPlanet(res)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "store.planet.GetAllByFilmID.Scan"}).Error(err)
+			return nil, err
+		}
+		results = append(results, planet)
+	}
+
+	return results, nil
+}
+
+func (a *storeImpl) GetTotalPlanetsByFilmID(ctx context.Context, filmID int64) (*int64, error) {
+	res, err := a.db.QueryContext(ctx, `SELECT COUNT(*) FROM planet
+		WHERE deleted_at IS NULL
+			AND id IN (SELECT planet_id FROM film_planet WHERE film_id = ? AND deleted_at IS NULL)`, filmID)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.planet.GetTotalPlanetsByFilmID.Query"}).Error(err)
+		return nil, err
+	}
+	defer res.Close()
+
+	if res.Next() {
+		var planet planetModel.PlanetsTotal
+		err := res.Scan(
+			&planet.Total,
+		)
+		if err != nil {
+			logrus.WithFields(logrus.Fields{"trace": "store.planet.GetTotalPlanetsByFilmID.Scan"}).Error(err)
+			return nil, err
+		}
+
+		return &planet.Total, nil
+	} else {
+		return nil, planetModel.ErrorPlanetNotFound
+	}
+}
+
+func isDuplicateEntry(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	return errors.As(err, &mysqlErr) && mysqlErr.Number == errorDuplicateEntry
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// scanPlanet read a row selected with planetColumns..
+func scanPlanet(res scanner) (*planetModel.PlanetDB, error) {
+	var planet planetModel.PlanetDB
+	err := res.Scan(
+		&planet.ID,
+		&planet.Name,
+		&planet.Climate,
+		&planet.Terrain,
+		&planet.RotationPeriod,
+		&planet.OrbitalPeriod,
+		&planet.Diameter,
+		&planet.Gravity,
+		&planet.SurfaceWater,
+		&planet.Population,
+		&planet.CreatedAt,
+		&planet.DeletedAt,
+		&planet.EditedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &planet, nil
+}
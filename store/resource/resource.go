@@ -0,0 +1,337 @@
+package resource
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"strings"
+	"time"
+
+	resourceModel "github.com/danilotadeu/star_wars/model/resource"
+	"github.com/danilotadeu/star_wars/store/filter"
+	"github.com/danilotadeu/star_wars/store/swapi"
+	"github.com/danilotadeu/star_wars/store/transaction"
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+)
+
+// Reader are the queries of a resource used by the api..
+type Reader[D resourceModel.Record] interface {
+	GetOneByID(ctx context.Context, id int64) (D, error)
+	GetAll(ctx context.Context, offset, limit int64, name string) ([]D, error)
+	GetTotal(ctx context.Context, name string) (*int64, error)
+	GetFilmsByIDs(ctx context.Context, ids []int64) ([]resourceModel.FilmLink, error)
+}
+
+// Queries are every query of a resource store but WithTx, that each resource declares returning its own Store..
+type Queries[R any, D resourceModel.Record] interface {
+	Reader[D]
+	Get(ctx context.Context, url string) (*R, error)
+	Save(ctx context.Context, result R) (*int64, error)
+	Update(ctx context.Context, id int64, result R) error
+	GetOne(ctx context.Context, name string) (D, error)
+	SaveFilm(ctx context.Context, id, filmID int64) error
+	GetFilm(ctx context.Context, id, filmID int64) (*resourceModel.FilmLink, error)
+}
+
+// Scanner is a row read by Spec.Scan..
+type Scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// Spec describe a SWAPI resource R saved on its own table and read as D..
+type Spec[R any, D resourceModel.Record] struct {
+	// Kind is the path of the resource on SWAPI, like starships..
+	Kind string
+	// Table is the table of the resource, linked to the films by film_<Table>.<Table>_id..
+	Table string
+	// Columns are the attributes copied from SWAPI, saved between name and edited_at..
+	Columns []string
+	// Values return the Columns of a SWAPI resource, in the same order..
+	Values func(result R) []interface{}
+	Name   func(result R) string
+	Edited func(result R) time.Time
+	// Scan read a row with id, name, the Columns, created_at and edited_at..
+	Scan func(res Scanner) (D, error)
+	// NotFound is returned when the resource asked by id does not exist..
+	NotFound error
+}
+
+// Store run the queries of the resource described by a Spec..
+type Store[R any, D resourceModel.Record] struct {
+	db          transaction.Executor
+	client      *http.Client
+	urlStarWars string
+	spec        Spec[R, D]
+}
+
+// NewStore init the store of the resource described by spec..
+func NewStore[R any, D resourceModel.Record](db transaction.Executor, urlStarWars string, client *http.Client, spec Spec[R, D]) *Store[R, D] {
+	return &Store[R, D]{
+		db:          db,
+		client:      client,
+		urlStarWars: urlStarWars,
+		spec:        spec,
+	}
+}
+
+// WithTx return a copy of the store running the queries inside tx..
+func (a *Store[R, D]) WithTx(tx *sql.Tx) *Store[R, D] {
+	return NewStore(tx, a.urlStarWars, a.client, a.spec)
+}
+
+func (a *Store[R, D]) trace(trace string) *logrus.Entry {
+	return logrus.WithFields(logrus.Fields{"trace": "store.resource." + trace, "resource": a.spec.Table})
+}
+
+func (a *Store[R, D]) columns() string {
+	return "id, name, " + strings.Join(a.spec.Columns, ", ") + ", created_at, edited_at"
+}
+
+// Get get a single resource in api star wars..
+func (a *Store[R, D]) Get(ctx context.Context, url string) (*R, error) {
+	url, err := swapi.ResourceURL(a.urlStarWars, a.spec.Kind, url)
+	if err != nil {
+		a.trace("Get.ResourceURL").Error(err)
+		return nil, err
+	}
+
+	var result R
+	err = swapi.Get(ctx, a.client, url, &result)
+	if err != nil {
+		a.trace("Get.Get").Error(err)
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (a *Store[R, D]) Save(ctx context.Context, result R) (*int64, error) {
+	params := []interface{}{a.spec.Name(result)}
+	params = append(params, a.spec.Values(result)...)
+	params = append(params, a.spec.Edited(result))
+
+	res, err := a.db.ExecContext(ctx, `INSERT INTO `+a.spec.Table+`(name, `+strings.Join(a.spec.Columns, ", ")+`, edited_at)
+		VALUES (?`+strings.Repeat(", ?", len(a.spec.Columns)+1)+`)`, params...)
+	if err != nil {
+		a.trace("Save.Exec").Error(err)
+		return nil, err
+	}
+
+	lastID, err := res.LastInsertId()
+	if err != nil {
+		a.trace("Save.LastInsertId").Error(err)
+		return nil, err
+	}
+
+	return &lastID, nil
+}
+
+func (a *Store[R, D]) Update(ctx context.Context, id int64, result R) error {
+	params := a.spec.Values(result)
+	params = append(params, a.spec.Edited(result), id)
+
+	_, err := a.db.ExecContext(ctx, `UPDATE `+a.spec.Table+` SET `+strings.Join(a.spec.Columns, " = ?, ")+` = ?, edited_at = ?
+		WHERE id = ?`, params...)
+	if err != nil {
+		a.trace("Update.Exec").Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// GetOne get the resource named name, nil when it does not exist..
+func (a *Store[R, D]) GetOne(ctx context.Context, name string) (D, error) {
+	var record D
+	res, err := a.db.QueryContext(ctx, "SELECT "+a.columns()+" FROM "+a.spec.Table+" WHERE name = ?", name)
+	if err != nil {
+		a.trace("GetOne.Query").Error(err)
+		return record, err
+	}
+	defer res.Close()
+
+	if res.Next() {
+		record, err = a.spec.Scan(res)
+		if err != nil {
+			a.trace("GetOne.Scan").Error(err)
+			return record, err
+		}
+	}
+
+	return record, nil
+}
+
+func (a *Store[R, D]) GetOneByID(ctx context.Context, id int64) (D, error) {
+	var record D
+	res, err := a.db.QueryContext(ctx, "SELECT "+a.columns()+" FROM "+a.spec.Table+" WHERE id = ?", id)
+	if err != nil {
+		a.trace("GetOneByID.Query").Error(err)
+		return record, err
+	}
+	defer res.Close()
+
+	if res.Next() {
+		record, err = a.spec.Scan(res)
+		if err != nil {
+			a.trace("GetOneByID.Scan").Error(err)
+			return record, err
+		}
+
+		return record, nil
+	} else {
+		return record, a.spec.NotFound
+	}
+}
+
+// GetAll list limit resources whose name contains name, skipping offset of them..
+func (a *Store[R, D]) GetAll(ctx context.Context, offset, limit int64, name string) ([]D, error) {
+	where, params := filter.NameContains(name)
+	query := `SELECT ` + a.columns() + ` FROM ` + a.spec.Table + where + ` ORDER BY id LIMIT ? OFFSET ?`
+	params = append(params, limit, offset)
+
+	res, err := a.db.QueryContext(ctx, query, params...)
+	if err != nil {
+		a.trace("GetAll.Query").Error(err)
+		return nil, err
+	}
+	defer res.Close()
+
+	var results []D
+	for res.Next() {
+		record, err := a.spec.Scan(res)
+		if err != nil {
+			a.trace("GetAll.Scan").Error(err)
+			return nil, err
+		}
+		results = append(results, record)
+	}
+
+	return results, nil
+}
+
+// GetTotal count the resources listed by GetAll with the same name..
+func (a *Store[R, D]) GetTotal(ctx context.Context, name string) (*int64, error) {
+	where, params := filter.NameContains(name)
+	res, err := a.db.QueryContext(ctx, "SELECT COUNT(*) FROM "+a.spec.Table+where, params...)
+	if err != nil {
+		a.trace("GetTotal.Query").Error(err)
+		return nil, err
+	}
+	defer res.Close()
+
+	if res.Next() {
+		var total int64
+		err := res.Scan(
+			&total,
+		)
+		if err != nil {
+			a.trace("GetTotal.Scan").Error(err)
+			return nil, err
+		}
+
+		return &total, nil
+	} else {
+		return nil, a.spec.NotFound
+	}
+}
+
+func (a *Store[R, D]) SaveFilm(ctx context.Context, id, filmID int64) error {
+	_, err := a.db.ExecContext(ctx, "INSERT INTO film_"+a.spec.Table+"("+a.spec.Table+"_id, film_id) VALUES (?, ?)", id, filmID)
+	if err != nil {
+		a.trace("SaveFilm.Exec").Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// GetFilm get the link between the resource and the film, nil when they are not linked..
+func (a *Store[R, D]) GetFilm(ctx context.Context, id, filmID int64) (*resourceModel.FilmLink, error) {
+	res, err := a.db.QueryContext(ctx, "SELECT "+a.spec.Table+"_id, film_id, created_at FROM film_"+a.spec.Table+" WHERE "+a.spec.Table+"_id = ? and film_id = ?", id, filmID)
+	if err != nil {
+		a.trace("GetFilm.Query").Error(err)
+		return nil, err
+	}
+	defer res.Close()
+
+	if res.Next() {
+		var film resourceModel.FilmLink
+		err := res.Scan(
+			&film.ResourceID,
+			&film.FilmID,
+			&film.CreatedAt,
+		)
+		if err != nil {
+			a.trace("GetFilm.Scan").Error(err)
+			return nil, err
+		}
+
+		return &film, nil
+	} else {
+		return nil, nil
+	}
+}
+
+// GetFilmsByIDs get the films of the resources with ids..
+func (a *Store[R, D]) GetFilmsByIDs(ctx context.Context, ids []int64) ([]resourceModel.FilmLink, error) {
+	link := "star_wars.film_" + a.spec.Table
+	query, args, err := sqlx.In(`SELECT
+						`+link+`.`+a.spec.Table+`_id,
+						`+link+`.film_id,
+						`+link+`.created_at,
+						star_wars.film.id,
+						star_wars.film.name,
+						star_wars.film.episode_id,
+						star_wars.film.opening_crawl,
+						star_wars.film.director,
+						star_wars.film.producer,
+						star_wars.film.release_date,
+						star_wars.film.created_at,
+						star_wars.film.edited_at
+					FROM
+						`+link+`
+							INNER JOIN
+						star_wars.film ON `+link+`.film_id = star_wars.film.id
+					WHERE `+link+`.`+a.spec.Table+`_id IN (?)
+					ORDER BY star_wars.film.episode_id IS NULL, star_wars.film.episode_id, star_wars.film.id;`, ids)
+	if err != nil {
+		a.trace("GetFilmsByIDs.In").Error(err)
+		return nil, err
+	}
+
+	query = sqlx.Rebind(sqlx.QUESTION, query)
+	res, err := a.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		a.trace("GetFilmsByIDs.Query").Error(err)
+		return nil, err
+	}
+	defer res.Close()
+
+	var films []resourceModel.FilmLink
+	for res.Next() {
+		var film resourceModel.FilmLink
+		err := res.Scan(
+			&film.ResourceID,
+			&film.FilmID,
+			&film.CreatedAt,
+			&film.Film.ID,
+			&film.Film.Name,
+			&film.Film.EpisodeID,
+			&film.Film.OpeningCrawl,
+			&film.Film.Director,
+			&film.Film.Producer,
+			&film.Film.ReleaseDate,
+			&film.Film.CreatedAt,
+			&film.Film.EditedAt,
+		)
+		if err != nil {
+			a.trace("GetFilmsByIDs.Scan").Error(err)
+			return nil, err
+		}
+
+		films = append(films, film)
+	}
+
+	return films, nil
+}
@@ -0,0 +1,90 @@
+package species
+
+import (
+	"database/sql"
+	"net/http"
+	"time"
+
+	speciesModel "github.com/danilotadeu/star_wars/model/species"
+	"github.com/danilotadeu/star_wars/store/resource"
+)
+
+// Store is a contract to Species..
+//
+//go:generate mockgen -destination ../../mock/store/species/species_store_mock.go -package mockStoreSpecies . Store
+type Store interface {
+	resource.Queries[speciesModel.ResultSpecies, *speciesModel.SpeciesDB]
+	WithTx(tx *sql.Tx) Store
+}
+
+var spec = resource.Spec[speciesModel.ResultSpecies, *speciesModel.SpeciesDB]{
+	Kind:  "species",
+	Table: "species",
+	Columns: []string{
+		"classification",
+		"designation",
+		"average_height",
+		"skin_colors",
+		"hair_colors",
+		"eye_colors",
+		"average_lifespan",
+		"language",
+	},
+	Values: func(species speciesModel.ResultSpecies) []interface{} {
+		return []interface{}{
+			species.Classification,
+			species.Designation,
+			species.AverageHeight,
+			species.SkinColors,
+			species.HairColors,
+			species.EyeColors,
+			species.AverageLifespan,
+			species.Language,
+		}
+	},
+	Name: func(species speciesModel.ResultSpecies) string {
+		return species.Name
+	},
+	Edited: func(species speciesModel.ResultSpecies) time.Time {
+		return species.Edited
+	},
+	Scan:     scanSpecies,
+	NotFound: speciesModel.ErrorSpeciesNotFound,
+}
+
+type storeImpl struct {
+	*resource.Store[speciesModel.ResultSpecies, *speciesModel.SpeciesDB]
+}
+
+// NewStore init a species
+func NewStore(db *sql.DB, urlStarWars string, client *http.Client) Store {
+	return &storeImpl{resource.NewStore(db, urlStarWars, client, spec)}
+}
+
+// WithTx return a copy of the store running the queries inside tx..
+func (a *storeImpl) WithTx(tx *sql.Tx) Store {
+	return &storeImpl{a.Store.WithTx(tx)}
+}
+
+func scanSpecies(res resource.Scanner) (*speciesModel.SpeciesDB, error) {
+	var species speciesModel.SpeciesDB
+	err := res.Scan(
+		&species.ID,
+		&species.Name,
+		&species.Classification,
+		&species.Designation,
+		&species.AverageHeight,
+		&species.SkinColors,
+		&species.HairColors,
+		&species.EyeColors,
+		&species.AverageLifespan,
+		&species.Language,
+		&species.CreatedAt,
+		&species.EditedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &species, nil
+}
@@ -0,0 +1,102 @@
+package starship
+
+import (
+	"database/sql"
+	"net/http"
+	"time"
+
+	starshipModel "github.com/danilotadeu/star_wars/model/starship"
+	"github.com/danilotadeu/star_wars/store/resource"
+)
+
+// Store is a contract to Starship..
+//
+//go:generate mockgen -destination ../../mock/store/starship/starship_store_mock.go -package mockStoreStarship . Store
+type Store interface {
+	resource.Queries[starshipModel.ResultStarship, *starshipModel.StarshipDB]
+	WithTx(tx *sql.Tx) Store
+}
+
+var spec = resource.Spec[starshipModel.ResultStarship, *starshipModel.StarshipDB]{
+	Kind:  "starships",
+	Table: "starship",
+	Columns: []string{
+		"model",
+		"manufacturer",
+		"cost_in_credits",
+		"length",
+		"max_atmosphering_speed",
+		"crew",
+		"passengers",
+		"cargo_capacity",
+		"consumables",
+		"hyperdrive_rating",
+		"mglt",
+		"starship_class",
+	},
+	Values: func(starship starshipModel.ResultStarship) []interface{} {
+		return []interface{}{
+			starship.Model,
+			starship.Manufacturer,
+			starship.CostInCredits,
+			starship.Length,
+			starship.MaxAtmospheringSpeed,
+			starship.Crew,
+			starship.Passengers,
+			starship.CargoCapacity,
+			starship.Consumables,
+			starship.HyperdriveRating,
+			starship.MGLT,
+			starship.StarshipClass,
+		}
+	},
+	Name: func(starship starshipModel.ResultStarship) string {
+		return starship.Name
+	},
+	Edited: func(starship starshipModel.ResultStarship) time.Time {
+		return starship.Edited
+	},
+	Scan:     scanStarship,
+	NotFound: starshipModel.ErrorStarshipNotFound,
+}
+
+type storeImpl struct {
+	*resource.Store[starshipModel.ResultStarship, *starshipModel.StarshipDB]
+}
+
+// NewStore init a starship
+func NewStore(db *sql.DB, urlStarWars string, client *http.Client) Store {
+	return &storeImpl{resource.NewStore(db, urlStarWars, client, spec)}
+}
+
+// WithTx return a copy of the store running the queries inside tx..
+func (a *storeImpl) WithTx(tx *sql.Tx) Store {
+	return &storeImpl{a.Store.WithTx(tx)}
+}
+
+func scanStarship(res resource.Scanner) (*starshipModel.StarshipDB, error) {
+	var starship starshipModel.StarshipDB
+	err := res.Scan(
+		&starship.ID,
+		&starship.Name,
+		&starship.Model,
+		&starship.Manufacturer,
+		&starship.CostInCredits,
+		&starship.Length,
+		&starship.MaxAtmospheringSpeed,
+		&starship.Crew,
+		&starship.Passengers,
+		&starship.CargoCapacity,
+		&starship.Consumables,
+		&starship.HyperdriveRating,
+		&starship.MGLT,
+		&starship.StarshipClass,
+		&starship.CreatedAt,
+		&starship.EditedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &starship, nil
+}
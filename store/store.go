@@ -2,9 +2,15 @@ package store
 
 import (
 	"database/sql"
+	"net/http"
 
 	"github.com/danilotadeu/star_wars/store/film"
+	"github.com/danilotadeu/star_wars/store/people"
 	"github.com/danilotadeu/star_wars/store/planet"
+	"github.com/danilotadeu/star_wars/store/species"
+	"github.com/danilotadeu/star_wars/store/starship"
+	"github.com/danilotadeu/star_wars/store/transaction"
+	"github.com/danilotadeu/star_wars/store/vehicle"
 	"github.com/sirupsen/logrus"
 
 	_ "github.com/go-sql-driver/mysql"
@@ -12,17 +18,40 @@ import (
 
 // Container ...
 type Container struct {
-	Planet planet.Store
-	Film   film.Store
+	Planet      planet.Store
+	Film        film.Store
+	People      people.Store
+	Starship    starship.Store
+	Vehicle     vehicle.Store
+	Species     species.Store
+	Transaction transaction.Store
 }
 
 // Register store container
-func Register(db *sql.DB, urlStarWars string) *Container {
+func Register(db *sql.DB, urlStarWars string, client *http.Client) *Container {
 	container := &Container{
-		Planet: planet.NewStore(db, urlStarWars),
-		Film:   film.NewStore(db, urlStarWars),
+		Planet:      planet.NewStore(db, urlStarWars, client),
+		Film:        film.NewStore(db, urlStarWars, client),
+		People:      people.NewStore(db, urlStarWars, client),
+		Starship:    starship.NewStore(db, urlStarWars, client),
+		Vehicle:     vehicle.NewStore(db, urlStarWars, client),
+		Species:     species.NewStore(db, urlStarWars, client),
+		Transaction: transaction.NewStore(db),
 	}
 
 	logrus.WithFields(logrus.Fields{"trace": "store"}).Infof("Registered - Store")
 	return container
 }
+
+// WithTx return a copy of the container with the stores running inside tx..
+func (c *Container) WithTx(tx *sql.Tx) *Container {
+	return &Container{
+		Planet:      c.Planet.WithTx(tx),
+		Film:        c.Film.WithTx(tx),
+		People:      c.People.WithTx(tx),
+		Starship:    c.Starship.WithTx(tx),
+		Vehicle:     c.Vehicle.WithTx(tx),
+		Species:     c.Species.WithTx(tx),
+		Transaction: c.Transaction,
+	}
+}
@@ -0,0 +1,47 @@
+package swapi
+
+import (
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// DefaultRateLimit is the number of requests per second sent to SWAPI when none is configured.
+	DefaultRateLimit = 10
+	// DefaultRateBurst is the number of requests sent at once when none is configured.
+	DefaultRateBurst = 10
+)
+
+type rateLimitTransport struct {
+	limiter *rate.Limiter
+	next    http.RoundTripper
+}
+
+// NewRateLimitTransport hold the requests of next on a token bucket refilled with perSecond tokens
+// every second and holding up to burst tokens. A perSecond of zero or less does not limit..
+func NewRateLimitTransport(next http.RoundTripper, perSecond float64, burst int) http.RoundTripper {
+	limit := rate.Limit(perSecond)
+	if perSecond <= 0 {
+		limit = rate.Inf
+	}
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &rateLimitTransport{
+		limiter: rate.NewLimiter(limit, burst),
+		next:    next,
+	}
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	err := t.limiter.Wait(req.Context())
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.swapi.rateLimitTransport.RoundTrip.Wait", "url": req.URL.String()}).Error(err)
+		return nil, err
+	}
+
+	return t.next.RoundTrip(req)
+}
@@ -0,0 +1,75 @@
+package swapi
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestRateLimitTransport(t *testing.T) {
+	var requests int32
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+	}))
+	defer upstream.Close()
+
+	cases := map[string]struct {
+		inputRateLimit   float64
+		inputBurst       int
+		inputRequests    int
+		expectedErr      bool
+		expectedRequests int32
+	}{
+		"should let the burst through": {
+			inputRateLimit:   0.001,
+			inputBurst:       3,
+			inputRequests:    3,
+			expectedErr:      false,
+			expectedRequests: 3,
+		},
+		"should stop waiting when context is done": {
+			inputRateLimit:   0.001,
+			inputBurst:       1,
+			inputRequests:    2,
+			expectedErr:      true,
+			expectedRequests: 1,
+		},
+		"should not limit without a rate": {
+			inputRateLimit:   0,
+			inputBurst:       1,
+			inputRequests:    5,
+			expectedErr:      false,
+			expectedRequests: 5,
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			// given
+			atomic.StoreInt32(&requests, 0)
+			client := &http.Client{Transport: NewRateLimitTransport(http.DefaultTransport, cs.inputRateLimit, cs.inputBurst)}
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+
+			// when
+			var err error
+			for i := 0; i < cs.inputRequests && err == nil; i++ {
+				req, _ := http.NewRequestWithContext(ctx, http.MethodGet, upstream.URL, nil)
+				var resp *http.Response
+				resp, err = client.Do(req)
+				if err == nil {
+					resp.Body.Close()
+				}
+			}
+
+			// then
+			assert.Equal(t, cs.expectedErr, err != nil)
+			assert.Equal(t, cs.expectedRequests, atomic.LoadInt32(&requests))
+		})
+	}
+}
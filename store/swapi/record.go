@@ -0,0 +1,80 @@
+package swapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/sirupsen/logrus"
+)
+
+type recordTransport struct {
+	dir  string
+	next http.RoundTripper
+}
+
+// NewRecordTransport save the body of every successful response of next on dir,
+// with the same layout read by NewSnapshotTransport. Existing files are replaced,
+// so recording again refreshes the snapshot..
+func NewRecordTransport(dir string, next http.RoundTripper) http.RoundTripper {
+	return &recordTransport{
+		dir:  dir,
+		next: next,
+	}
+}
+
+func (t *recordTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		return resp, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.swapi.recordTransport.RoundTrip.readAll"}).Error(err)
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	err = t.save(SnapshotPath(req), body)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.swapi.recordTransport.RoundTrip.save"}).Error(err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// save write the body indented, through a temporary file so an interrupted recording never leaves a half written fixture..
+func (t *recordTransport) save(name string, body []byte) error {
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, body, "", "  "); err == nil {
+		indented.WriteString("\n")
+		body = indented.Bytes()
+	}
+
+	path := filepath.Join(t.dir, name)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".record-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(body); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
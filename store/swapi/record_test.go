@@ -0,0 +1,67 @@
+package swapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestRecordTransport(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/films/1":
+			w.Write([]byte(`{"title":"A New Hope"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"detail":"Not found"}`))
+		}
+	}))
+	defer upstream.Close()
+
+	cases := map[string]struct {
+		inputPath          string
+		expectedStatusCode int
+		expectedFile       string
+		expectedRecorded   string
+	}{
+		"should record a successful response": {
+			inputPath:          "/api/films/1",
+			expectedStatusCode: http.StatusOK,
+			expectedFile:       "films/1.json",
+			expectedRecorded:   "{\n  \"title\": \"A New Hope\"\n}\n",
+		},
+		"should not record a failed response": {
+			inputPath:          "/api/films/7",
+			expectedStatusCode: http.StatusNotFound,
+			expectedFile:       "films/7.json",
+			expectedRecorded:   "",
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			// given
+			dir := t.TempDir()
+			client := NewClient(Config{RecordDir: dir})
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, upstream.URL+cs.inputPath, nil)
+
+			// when
+			resp, err := client.Do(req)
+
+			// then
+			assert.Equal(t, nil, err)
+			defer resp.Body.Close()
+			_, _ = io.ReadAll(resp.Body)
+			assert.Equal(t, cs.expectedStatusCode, resp.StatusCode)
+
+			recorded, _ := os.ReadFile(filepath.Join(dir, cs.expectedFile))
+			assert.Equal(t, cs.expectedRecorded, string(recorded))
+		})
+	}
+}
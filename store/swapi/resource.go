@@ -0,0 +1,70 @@
+package swapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	swapiModel "github.com/danilotadeu/star_wars/model/swapi"
+	"github.com/sirupsen/logrus"
+)
+
+// ResourceURL return the url of a resource of SWAPI, like https://swapi.dev/api/people/1/, on base,
+// so the imports keep calling the api configured even when SWAPI answers with the urls of another host.
+// It is swapiModel.ErrorInvalidURL when resource is not an url of kind with an id..
+func ResourceURL(base, kind, resource string) (string, error) {
+	parsed, err := url.Parse(resource)
+	if err != nil {
+		return "", fmt.Errorf("%w: %s", swapiModel.ErrorInvalidURL, resource)
+	}
+
+	segments := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+	if len(segments) < 2 || segments[len(segments)-2] != kind || len(segments[len(segments)-1]) == 0 {
+		return "", fmt.Errorf("%w: %s", swapiModel.ErrorInvalidURL, resource)
+	}
+
+	return strings.TrimRight(base, "/") + "/" + kind + "/" + segments[len(segments)-1] + "/", nil
+}
+
+// Get request url with client and decode the json of the response on out.
+// Responses that are not 200 or can not be decoded return a swapiModel.UpstreamError..
+func Get(ctx context.Context, client *http.Client, url string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.swapi.Get.newRequest"}).Error(err)
+		return err
+	}
+	req.Header.Add("Accept", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.swapi.Get.Do"}).Error(err)
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.swapi.Get.readAll"}).Error(err)
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		err := swapiModel.NewStatusError(url, resp)
+		logrus.WithFields(logrus.Fields{"trace": "store.swapi.Get.StatusCode"}).Error(err)
+		return err
+	}
+
+	err = json.Unmarshal(respBody, out)
+	if err != nil {
+		err := swapiModel.NewMalformedBodyError(url, resp.StatusCode, err)
+		logrus.WithFields(logrus.Fields{"trace": "store.swapi.Get.jsonUnmarshal"}).Error(err)
+		return err
+	}
+
+	return nil
+}
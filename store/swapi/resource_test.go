@@ -0,0 +1,98 @@
+package swapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	swapiModel "github.com/danilotadeu/star_wars/model/swapi"
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestResourceURL(t *testing.T) {
+	cases := map[string]struct {
+		inputResource string
+		expectedURL   string
+		expectedErr   error
+	}{
+		"should keep the url of the api configured": {
+			inputResource: "https://swapi.dev/api/people/1/",
+			expectedURL:   "http://localhost:8080/api/people/1/",
+		},
+		"should move the url of another host to the api configured": {
+			inputResource: "https://swapi.py4e.com/api/people/12",
+			expectedURL:   "http://localhost:8080/api/people/12/",
+		},
+		"should throw error when it is another resource": {
+			inputResource: "https://swapi.dev/api/planets/1/",
+			expectedErr:   swapiModel.ErrorInvalidURL,
+		},
+		"should throw error when it does not have id": {
+			inputResource: "https://swapi.dev/api/people/",
+			expectedErr:   swapiModel.ErrorInvalidURL,
+		},
+		"should throw error when it is not an url": {
+			inputResource: "://people/1",
+			expectedErr:   swapiModel.ErrorInvalidURL,
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			url, err := ResourceURL("http://localhost:8080/api/", "people", cs.inputResource)
+			assert.Equal(t, cs.expectedErr == nil, err == nil)
+			assert.Equal(t, true, errors.Is(err, cs.expectedErr))
+			assert.Equal(t, cs.expectedURL, url)
+		})
+	}
+}
+
+func TestGet(t *testing.T) {
+	cases := map[string]struct {
+		inputStatus  int
+		inputBody    string
+		expectedName string
+		expectedErr  error
+	}{
+		"should decode the resource": {
+			inputStatus:  http.StatusOK,
+			inputBody:    `{"name":"Luke Skywalker"}`,
+			expectedName: "Luke Skywalker",
+		},
+		"should throw error when not found": {
+			inputStatus: http.StatusNotFound,
+			inputBody:   `{"detail":"Not found"}`,
+			expectedErr: swapiModel.ErrorNotFound,
+		},
+		"should throw error when the body is malformed": {
+			inputStatus: http.StatusOK,
+			inputBody:   `{"name":`,
+			expectedErr: swapiModel.ErrorMalformedBody,
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			// given
+			upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(cs.inputStatus)
+				w.Write([]byte(cs.inputBody))
+			}))
+			defer upstream.Close()
+
+			var result struct {
+				Name string `json:"name"`
+			}
+
+			// when
+			err := Get(context.Background(), upstream.Client(), upstream.URL+"/people/1/", &result)
+
+			// then
+			assert.Equal(t, cs.expectedErr == nil, err == nil)
+			assert.Equal(t, true, errors.Is(err, cs.expectedErr))
+			assert.Equal(t, cs.expectedName, result.Name)
+		})
+	}
+}
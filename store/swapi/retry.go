@@ -0,0 +1,192 @@
+package swapi
+
+import (
+	"errors"
+	"io"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+
+	swapiModel "github.com/danilotadeu/star_wars/model/swapi"
+	"github.com/sirupsen/logrus"
+)
+
+// ErrCircuitOpen is returned without calling SWAPI while the circuit breaker is open.
+var ErrCircuitOpen = errors.New("SWAPI circuit breaker is open")
+
+// RetryConfig define how the failed requests are retried..
+type RetryConfig struct {
+	// MaxAttempts is the number of tries of a request, counting the first one.
+	MaxAttempts int
+	// BaseDelay is doubled on every attempt, up to MaxDelay, with a random jitter.
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// BreakerThreshold consecutive failures open the circuit for BreakerCooldown.
+	BreakerThreshold int
+	BreakerCooldown  time.Duration
+}
+
+// DefaultRetryConfig is used for the fields not set on RetryConfig.
+var DefaultRetryConfig = RetryConfig{
+	MaxAttempts:      4,
+	BaseDelay:        500 * time.Millisecond,
+	MaxDelay:         10 * time.Second,
+	BreakerThreshold: 10,
+	BreakerCooldown:  30 * time.Second,
+}
+
+type retryTransport struct {
+	config  RetryConfig
+	next    http.RoundTripper
+	breaker *circuitBreaker
+}
+
+// NewRetryTransport retry the requests of next failing with a transport error, 5xx or 429,
+// waiting an exponential backoff with jitter or the Retry-After sent by the server.
+// After BreakerThreshold consecutive failures the requests fail fast with ErrCircuitOpen
+// until BreakerCooldown has passed..
+func NewRetryTransport(next http.RoundTripper, config RetryConfig) http.RoundTripper {
+	if config.MaxAttempts < 1 {
+		config.MaxAttempts = DefaultRetryConfig.MaxAttempts
+	}
+	if config.BaseDelay <= 0 {
+		config.BaseDelay = DefaultRetryConfig.BaseDelay
+	}
+	if config.MaxDelay <= 0 {
+		config.MaxDelay = DefaultRetryConfig.MaxDelay
+	}
+	if config.BreakerThreshold < 1 {
+		config.BreakerThreshold = DefaultRetryConfig.BreakerThreshold
+	}
+	if config.BreakerCooldown <= 0 {
+		config.BreakerCooldown = DefaultRetryConfig.BreakerCooldown
+	}
+
+	return &retryTransport{
+		config: config,
+		next:   next,
+		breaker: &circuitBreaker{
+			threshold: config.BreakerThreshold,
+			cooldown:  config.BreakerCooldown,
+		},
+	}
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	for attempt := 1; ; attempt++ {
+		if !t.breaker.allow() {
+			logrus.WithFields(logrus.Fields{"trace": "store.swapi.retryTransport.RoundTrip.breaker", "url": req.URL.String()}).Error(ErrCircuitOpen)
+			return nil, ErrCircuitOpen
+		}
+
+		resp, err := t.next.RoundTrip(req)
+		if ctx.Err() != nil {
+			return resp, err
+		}
+
+		if !retryable(resp, err) {
+			t.breaker.success()
+			return resp, err
+		}
+		t.breaker.failure()
+
+		if attempt >= t.config.MaxAttempts || (req.Body != nil && req.GetBody == nil) {
+			return resp, err
+		}
+
+		delay := t.delay(attempt, resp)
+		fields := logrus.Fields{
+			"trace":   "store.swapi.retryTransport.RoundTrip",
+			"url":     req.URL.String(),
+			"attempt": attempt,
+			"delay":   delay.String(),
+		}
+		if err != nil {
+			logrus.WithFields(fields).Warn(err)
+		} else {
+			logrus.WithFields(fields).Warnf("status %d", resp.StatusCode)
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		}
+
+		if req.GetBody != nil {
+			req.Body, err = req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// delay return the Retry-After of the response when present, otherwise the backoff of the attempt,
+// never waiting more than MaxDelay..
+func (t *retryTransport) delay(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if retryAfter, ok := swapiModel.ParseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if retryAfter > t.config.MaxDelay {
+				retryAfter = t.config.MaxDelay
+			}
+			return retryAfter
+		}
+	}
+
+	backoff := t.config.BaseDelay << (attempt - 1)
+	if backoff > t.config.MaxDelay || backoff <= 0 {
+		backoff = t.config.MaxDelay
+	}
+
+	return backoff/2 + time.Duration(rand.Int63n(int64(backoff/2)+1))
+}
+
+func retryable(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+}
+
+type circuitBreaker struct {
+	mu        sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	failures  int
+	openUntil time.Time
+}
+
+// allow report if a request can be made. Once the cooldown has passed the requests are
+// let through again, and a single failure opens the circuit back..
+func (b *circuitBreaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return time.Now().After(b.openUntil)
+}
+
+func (b *circuitBreaker) success() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures = 0
+}
+
+func (b *circuitBreaker) failure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	if b.failures >= b.threshold {
+		b.openUntil = time.Now().Add(b.cooldown)
+		b.failures = b.threshold - 1
+	}
+}
@@ -0,0 +1,138 @@
+package swapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestRetryTransport(t *testing.T) {
+	config := RetryConfig{
+		MaxAttempts:      3,
+		BaseDelay:        time.Millisecond,
+		MaxDelay:         5 * time.Millisecond,
+		BreakerThreshold: 100,
+		BreakerCooldown:  time.Minute,
+	}
+
+	cases := map[string]struct {
+		inputStatus        []int
+		inputRetryAfter    string
+		expectedStatusCode int
+		expectedAttempts   int32
+	}{
+		"should not retry a successful response": {
+			inputStatus:        []int{http.StatusOK},
+			expectedStatusCode: http.StatusOK,
+			expectedAttempts:   1,
+		},
+		"should not retry a not found": {
+			inputStatus:        []int{http.StatusNotFound},
+			expectedStatusCode: http.StatusNotFound,
+			expectedAttempts:   1,
+		},
+		"should retry a server error": {
+			inputStatus:        []int{http.StatusBadGateway, http.StatusInternalServerError, http.StatusOK},
+			expectedStatusCode: http.StatusOK,
+			expectedAttempts:   3,
+		},
+		"should retry a rate limited response honoring retry after": {
+			inputStatus:        []int{http.StatusTooManyRequests, http.StatusOK},
+			inputRetryAfter:    "0",
+			expectedStatusCode: http.StatusOK,
+			expectedAttempts:   2,
+		},
+		"should wait at most the max delay when retry after is longer": {
+			inputStatus:        []int{http.StatusTooManyRequests, http.StatusOK},
+			inputRetryAfter:    "3600",
+			expectedStatusCode: http.StatusOK,
+			expectedAttempts:   2,
+		},
+		"should give up after the max attempts": {
+			inputStatus:        []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK},
+			expectedStatusCode: http.StatusServiceUnavailable,
+			expectedAttempts:   3,
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			// given
+			var attempts int32
+			upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				attempt := atomic.AddInt32(&attempts, 1)
+				if len(cs.inputRetryAfter) > 0 {
+					w.Header().Set("Retry-After", cs.inputRetryAfter)
+				}
+				w.WriteHeader(cs.inputStatus[attempt-1])
+			}))
+			defer upstream.Close()
+
+			client := &http.Client{Transport: NewRetryTransport(http.DefaultTransport, config)}
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, upstream.URL, nil)
+
+			// when
+			resp, err := client.Do(req)
+
+			// then
+			assert.Equal(t, nil, err)
+			resp.Body.Close()
+			assert.Equal(t, cs.expectedStatusCode, resp.StatusCode)
+			assert.Equal(t, cs.expectedAttempts, atomic.LoadInt32(&attempts))
+		})
+	}
+}
+
+func TestRetryTransportCircuitBreaker(t *testing.T) {
+	var attempts int32
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer upstream.Close()
+
+	client := &http.Client{Transport: NewRetryTransport(http.DefaultTransport, RetryConfig{
+		MaxAttempts:      2,
+		BaseDelay:        time.Millisecond,
+		MaxDelay:         time.Millisecond,
+		BreakerThreshold: 3,
+		BreakerCooldown:  time.Minute,
+	})}
+
+	for i := 0; i < 3; i++ {
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, upstream.URL, nil)
+		resp, err := client.Do(req)
+		if err == nil {
+			resp.Body.Close()
+		}
+
+		if i == 2 {
+			assert.Equal(t, true, errors.Is(err, ErrCircuitOpen))
+		}
+	}
+
+	assert.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+}
+
+func TestRetryTransportContextCanceled(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer upstream.Close()
+
+	client := &http.Client{Transport: NewRetryTransport(http.DefaultTransport, RetryConfig{})}
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, upstream.URL, nil)
+
+	_, err := client.Do(req)
+
+	assert.Equal(t, true, errors.Is(err, context.DeadlineExceeded))
+}
@@ -0,0 +1,76 @@
+package swapi
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+type snapshotTransport struct {
+	dir string
+}
+
+// NewSnapshotTransport answer the requests with the files of a snapshot directory:
+// list pages are read from <resource>/page-N.json and single resources from <resource>/<id>.json.
+// Files not found are answered with 404, as SWAPI does..
+func NewSnapshotTransport(dir string) http.RoundTripper {
+	return &snapshotTransport{
+		dir: dir,
+	}
+}
+
+func (t *snapshotTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := req.Context().Err(); err != nil {
+		return nil, err
+	}
+
+	body, err := os.ReadFile(filepath.Join(t.dir, SnapshotPath(req)))
+	if errors.Is(err, fs.ErrNotExist) {
+		return snapshotResponse(req, http.StatusNotFound, []byte(`{"detail":"Not found"}`)), nil
+	}
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.swapi.snapshotTransport.RoundTrip.ReadFile"}).Error(err)
+		return nil, err
+	}
+
+	return snapshotResponse(req, http.StatusOK, body), nil
+}
+
+// SnapshotPath return the file of a snapshot directory holding the response of req..
+func SnapshotPath(req *http.Request) string {
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	last := segments[len(segments)-1]
+
+	if _, err := strconv.Atoi(last); err == nil && len(segments) > 1 {
+		return filepath.Join(segments[len(segments)-2], last+".json")
+	}
+
+	page := req.URL.Query().Get("page")
+	if len(page) == 0 {
+		page = "1"
+	}
+
+	return filepath.Join(last, "page-"+page+".json")
+}
+
+func snapshotResponse(req *http.Request, status int, body []byte) *http.Response {
+	return &http.Response{
+		Status:        strconv.Itoa(status) + " " + http.StatusText(status),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
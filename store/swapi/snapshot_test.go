@@ -0,0 +1,70 @@
+package swapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestSnapshotTransport(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"planets/page-1.json": `{"count":1}`,
+		"planets/page-2.json": `{"count":2}`,
+		"films/1.json":        `{"title":"A New Hope"}`,
+	}
+	for name, body := range files {
+		_ = os.MkdirAll(filepath.Join(dir, filepath.Dir(name)), 0o755)
+		_ = os.WriteFile(filepath.Join(dir, name), []byte(body), 0o644)
+	}
+
+	cases := map[string]struct {
+		inputURL           string
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		"should read the first page of planets": {
+			inputURL:           "https://swapi.dev/api/planets",
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"count":1}`,
+		},
+		"should read a page of planets": {
+			inputURL:           "https://swapi.dev/api/planets/?page=2",
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"count":2}`,
+		},
+		"should read a film": {
+			inputURL:           "https://swapi.dev/api/films/1",
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"title":"A New Hope"}`,
+		},
+		"should return not found when the file does not exist": {
+			inputURL:           "https://swapi.dev/api/films/7",
+			expectedStatusCode: http.StatusNotFound,
+			expectedBody:       `{"detail":"Not found"}`,
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			// given
+			client := NewClient(Config{SnapshotDir: dir})
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, cs.inputURL, nil)
+
+			// when
+			resp, err := client.Do(req)
+
+			// then
+			assert.Equal(t, nil, err)
+			defer resp.Body.Close()
+			body, _ := io.ReadAll(resp.Body)
+			assert.Equal(t, cs.expectedStatusCode, resp.StatusCode)
+			assert.Equal(t, cs.expectedBody, string(body))
+		})
+	}
+}
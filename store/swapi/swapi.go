@@ -0,0 +1,70 @@
+package swapi
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+)
+
+// Config define how the requests to SWAPI are made..
+type Config struct {
+	// SnapshotDir serves the responses from a directory of SWAPI json instead of the network.
+	SnapshotDir string
+	// RecordDir saves the responses of the network on a directory that SnapshotDir can replay.
+	RecordDir string
+	Retry     RetryConfig
+	// RateLimit is the number of requests per second shared by every request, RateBurst how many can go at once.
+	RateLimit float64
+	RateBurst int
+}
+
+// ConfigFromEnv read the Config of the SWAPI_RATE_LIMIT_RPS, SWAPI_RATE_LIMIT_BURST and SWAPI_SNAPSHOT_DIR envs,
+// falling back to DefaultRateLimit and DefaultRateBurst..
+func ConfigFromEnv() (Config, error) {
+	config := Config{
+		SnapshotDir: os.Getenv("SWAPI_SNAPSHOT_DIR"),
+		RateLimit:   DefaultRateLimit,
+		RateBurst:   DefaultRateBurst,
+	}
+
+	if value := os.Getenv("SWAPI_RATE_LIMIT_RPS"); len(value) > 0 {
+		rateLimit, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return Config{}, fmt.Errorf("parsing SWAPI_RATE_LIMIT_RPS: %w", err)
+		}
+		config.RateLimit = rateLimit
+	}
+
+	if value := os.Getenv("SWAPI_RATE_LIMIT_BURST"); len(value) > 0 {
+		rateBurst, err := strconv.Atoi(value)
+		if err != nil {
+			return Config{}, fmt.Errorf("parsing SWAPI_RATE_LIMIT_BURST: %w", err)
+		}
+		config.RateBurst = rateBurst
+	}
+
+	return config, nil
+}
+
+// NewClient return the http client shared by the stores to call SWAPI..
+func NewClient(config Config) *http.Client {
+	network := http.DefaultTransport.(*http.Transport).Clone()
+	network.ResponseHeaderTimeout = 30 * time.Second
+
+	var transport http.RoundTripper = network
+	if len(config.SnapshotDir) > 0 {
+		transport = NewSnapshotTransport(config.SnapshotDir)
+	} else {
+		transport = NewRateLimitTransport(transport, config.RateLimit, config.RateBurst)
+		transport = NewRetryTransport(transport, config.Retry)
+		if len(config.RecordDir) > 0 {
+			transport = NewRecordTransport(config.RecordDir, transport)
+		}
+	}
+
+	return &http.Client{
+		Transport: transport,
+	}
+}
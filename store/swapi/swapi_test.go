@@ -0,0 +1,47 @@
+package swapi
+
+import (
+	"testing"
+
+	"gopkg.in/go-playground/assert.v1"
+)
+
+func TestConfigFromEnv(t *testing.T) {
+	cases := map[string]struct {
+		inputRateLimit string
+		inputRateBurst string
+		inputSnapshot  string
+		expected       Config
+		expectedErr    bool
+	}{
+		"should use the defaults when the envs are empty": {
+			expected: Config{RateLimit: DefaultRateLimit, RateBurst: DefaultRateBurst},
+		},
+		"should read the envs": {
+			inputRateLimit: "2.5",
+			inputRateBurst: "0",
+			inputSnapshot:  "./snapshot",
+			expected:       Config{SnapshotDir: "./snapshot", RateLimit: 2.5, RateBurst: 0},
+		},
+		"should fail with an invalid rate limit": {
+			inputRateLimit: "fast",
+			expectedErr:    true,
+		},
+		"should fail with an invalid burst": {
+			inputRateBurst: "1.5",
+			expectedErr:    true,
+		},
+	}
+
+	for name, cs := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("SWAPI_RATE_LIMIT_RPS", cs.inputRateLimit)
+			t.Setenv("SWAPI_RATE_LIMIT_BURST", cs.inputRateBurst)
+			t.Setenv("SWAPI_SNAPSHOT_DIR", cs.inputSnapshot)
+
+			config, err := ConfigFromEnv()
+			assert.Equal(t, cs.expectedErr, err != nil)
+			assert.Equal(t, cs.expected, config)
+		})
+	}
+}
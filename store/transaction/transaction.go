@@ -0,0 +1,56 @@
+package transaction
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Executor is satisfied by *sql.DB and *sql.Tx, so the stores run the same queries inside or outside a transaction..
+type Executor interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+}
+
+// Store is a contract to Transaction..
+//
+//go:generate mockgen -destination ../../mock/store/transaction/transaction_store_mock.go -package mockStoreTransaction . Store
+type Store interface {
+	Run(ctx context.Context, fn func(tx *sql.Tx) error) error
+}
+
+type storeImpl struct {
+	db *sql.DB
+}
+
+// NewStore init a transaction
+func NewStore(db *sql.DB) Store {
+	return &storeImpl{
+		db: db,
+	}
+}
+
+// Run calls fn inside a transaction, committing when fn returns nil and rolling back otherwise..
+func (a *storeImpl) Run(ctx context.Context, fn func(tx *sql.Tx) error) error {
+	tx, err := a.db.BeginTx(ctx, nil)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.transaction.Run.BeginTx"}).Error(err)
+		return err
+	}
+	defer tx.Rollback()
+
+	err = fn(tx)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.transaction.Run.fn"}).Error(err)
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		logrus.WithFields(logrus.Fields{"trace": "store.transaction.Run.Commit"}).Error(err)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package vehicle
+
+import (
+	"database/sql"
+	"net/http"
+	"time"
+
+	vehicleModel "github.com/danilotadeu/star_wars/model/vehicle"
+	"github.com/danilotadeu/star_wars/store/resource"
+)
+
+// Store is a contract to Vehicle..
+//
+//go:generate mockgen -destination ../../mock/store/vehicle/vehicle_store_mock.go -package mockStoreVehicle . Store
+type Store interface {
+	resource.Queries[vehicleModel.ResultVehicle, *vehicleModel.VehicleDB]
+	WithTx(tx *sql.Tx) Store
+}
+
+var spec = resource.Spec[vehicleModel.ResultVehicle, *vehicleModel.VehicleDB]{
+	Kind:  "vehicles",
+	Table: "vehicle",
+	Columns: []string{
+		"model",
+		"manufacturer",
+		"cost_in_credits",
+		"length",
+		"max_atmosphering_speed",
+		"crew",
+		"passengers",
+		"cargo_capacity",
+		"consumables",
+		"vehicle_class",
+	},
+	Values: func(vehicle vehicleModel.ResultVehicle) []interface{} {
+		return []interface{}{
+			vehicle.Model,
+			vehicle.Manufacturer,
+			vehicle.CostInCredits,
+			vehicle.Length,
+			vehicle.MaxAtmospheringSpeed,
+			vehicle.Crew,
+			vehicle.Passengers,
+			vehicle.CargoCapacity,
+			vehicle.Consumables,
+			vehicle.VehicleClass,
+		}
+	},
+	Name: func(vehicle vehicleModel.ResultVehicle) string {
+		return vehicle.Name
+	},
+	Edited: func(vehicle vehicleModel.ResultVehicle) time.Time {
+		return vehicle.Edited
+	},
+	Scan:     scanVehicle,
+	NotFound: vehicleModel.ErrorVehicleNotFound,
+}
+
+type storeImpl struct {
+	*resource.Store[vehicleModel.ResultVehicle, *vehicleModel.VehicleDB]
+}
+
+// NewStore init a vehicle
+func NewStore(db *sql.DB, urlStarWars string, client *http.Client) Store {
+	return &storeImpl{resource.NewStore(db, urlStarWars, client, spec)}
+}
+
+// WithTx return a copy of the store running the queries inside tx..
+func (a *storeImpl) WithTx(tx *sql.Tx) Store {
+	return &storeImpl{a.Store.WithTx(tx)}
+}
+
+func scanVehicle(res resource.Scanner) (*vehicleModel.VehicleDB, error) {
+	var vehicle vehicleModel.VehicleDB
+	err := res.Scan(
+		&vehicle.ID,
+		&vehicle.Name,
+		&vehicle.Model,
+		&vehicle.Manufacturer,
+		&vehicle.CostInCredits,
+		&vehicle.Length,
+		&vehicle.MaxAtmospheringSpeed,
+		&vehicle.Crew,
+		&vehicle.Passengers,
+		&vehicle.CargoCapacity,
+		&vehicle.Consumables,
+		&vehicle.VehicleClass,
+		&vehicle.CreatedAt,
+		&vehicle.EditedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vehicle, nil
+}